@@ -0,0 +1,430 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+)
+
+// ToBigInt casts an interface to a big.Int type.
+// A float value is truncated toward zero, and a big.Rat value must be an integer.
+func ToBigInt(from any, to *big.Int) error {
+	if to == nil {
+		return newErrorCast(from, to)
+	}
+	setFloat := func(v float64) error {
+		if math.IsNaN(v) {
+			return newErrorNaN(v, to)
+		}
+		if math.IsInf(v, 0) {
+			return newErrorInfinity(v, to)
+		}
+		new(big.Float).SetFloat64(v).Int(to)
+		return nil
+	}
+	setString := func(s string) error {
+		if _, ok := to.SetString(s, 10); !ok {
+			return newErrorSyntax(s, to)
+		}
+		return nil
+	}
+	switch from := from.(type) {
+	case int:
+		to.SetInt64(int64(from))
+	case *int:
+		to.SetInt64(int64(*from))
+	case int8:
+		to.SetInt64(int64(from))
+	case *int8:
+		to.SetInt64(int64(*from))
+	case int16:
+		to.SetInt64(int64(from))
+	case *int16:
+		to.SetInt64(int64(*from))
+	case int32:
+		to.SetInt64(int64(from))
+	case *int32:
+		to.SetInt64(int64(*from))
+	case int64:
+		to.SetInt64(from)
+	case *int64:
+		to.SetInt64(*from)
+	case uint:
+		to.SetUint64(uint64(from))
+	case *uint:
+		to.SetUint64(uint64(*from))
+	case uint8:
+		to.SetUint64(uint64(from))
+	case *uint8:
+		to.SetUint64(uint64(*from))
+	case uint16:
+		to.SetUint64(uint64(from))
+	case *uint16:
+		to.SetUint64(uint64(*from))
+	case uint32:
+		to.SetUint64(uint64(from))
+	case *uint32:
+		to.SetUint64(uint64(*from))
+	case uint64:
+		to.SetUint64(from)
+	case *uint64:
+		to.SetUint64(*from)
+	case float32:
+		return setFloat(float64(from))
+	case *float32:
+		return setFloat(float64(*from))
+	case float64:
+		return setFloat(from)
+	case *float64:
+		return setFloat(*from)
+	case bool:
+		to.SetInt64(boolToInt64(from))
+	case *bool:
+		to.SetInt64(boolToInt64(*from))
+	case string:
+		return setString(from)
+	case *string:
+		return setString(*from)
+	case []byte:
+		return setString(string(from))
+	case json.Number:
+		return setString(string(from))
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToBigInt(v, to)
+	default:
+		return newErrorCast(from, to)
+	}
+	return nil
+}
+
+// ToBigFloat casts an interface to a big.Float type.
+// The precision of the destination is used if it is set, otherwise the precision is set by the value.
+func ToBigFloat(from any, to *big.Float) error {
+	if to == nil {
+		return newErrorCast(from, to)
+	}
+	setFloat := func(v float64) error {
+		if math.IsNaN(v) {
+			return newErrorNaN(v, to)
+		}
+		to.SetFloat64(v)
+		return nil
+	}
+	setString := func(s string) error {
+		if _, ok := to.SetString(s); !ok {
+			return newErrorSyntax(s, to)
+		}
+		return nil
+	}
+	switch from := from.(type) {
+	case int:
+		to.SetInt64(int64(from))
+	case *int:
+		to.SetInt64(int64(*from))
+	case int8:
+		to.SetInt64(int64(from))
+	case *int8:
+		to.SetInt64(int64(*from))
+	case int16:
+		to.SetInt64(int64(from))
+	case *int16:
+		to.SetInt64(int64(*from))
+	case int32:
+		to.SetInt64(int64(from))
+	case *int32:
+		to.SetInt64(int64(*from))
+	case int64:
+		to.SetInt64(from)
+	case *int64:
+		to.SetInt64(*from)
+	case uint:
+		to.SetUint64(uint64(from))
+	case *uint:
+		to.SetUint64(uint64(*from))
+	case uint8:
+		to.SetUint64(uint64(from))
+	case *uint8:
+		to.SetUint64(uint64(*from))
+	case uint16:
+		to.SetUint64(uint64(from))
+	case *uint16:
+		to.SetUint64(uint64(*from))
+	case uint32:
+		to.SetUint64(uint64(from))
+	case *uint32:
+		to.SetUint64(uint64(*from))
+	case uint64:
+		to.SetUint64(from)
+	case *uint64:
+		to.SetUint64(*from)
+	case float32:
+		return setFloat(float64(from))
+	case *float32:
+		return setFloat(float64(*from))
+	case float64:
+		return setFloat(from)
+	case *float64:
+		return setFloat(*from)
+	case bool:
+		to.SetInt64(boolToInt64(from))
+	case *bool:
+		to.SetInt64(boolToInt64(*from))
+	case string:
+		return setString(from)
+	case *string:
+		return setString(*from)
+	case []byte:
+		return setString(string(from))
+	case json.Number:
+		return setString(string(from))
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToBigFloat(v, to)
+	default:
+		return newErrorCast(from, to)
+	}
+	return nil
+}
+
+// ToBigRat casts an interface to a big.Rat type. A float value is converted exactly.
+func ToBigRat(from any, to *big.Rat) error {
+	if to == nil {
+		return newErrorCast(from, to)
+	}
+	setFloat := func(v float64) error {
+		if math.IsNaN(v) {
+			return newErrorNaN(v, to)
+		}
+		if math.IsInf(v, 0) {
+			return newErrorInfinity(v, to)
+		}
+		to.SetFloat64(v)
+		return nil
+	}
+	setString := func(s string) error {
+		if _, ok := to.SetString(s); !ok {
+			return newErrorSyntax(s, to)
+		}
+		return nil
+	}
+	switch from := from.(type) {
+	case int:
+		to.SetInt64(int64(from))
+	case *int:
+		to.SetInt64(int64(*from))
+	case int8:
+		to.SetInt64(int64(from))
+	case *int8:
+		to.SetInt64(int64(*from))
+	case int16:
+		to.SetInt64(int64(from))
+	case *int16:
+		to.SetInt64(int64(*from))
+	case int32:
+		to.SetInt64(int64(from))
+	case *int32:
+		to.SetInt64(int64(*from))
+	case int64:
+		to.SetInt64(from)
+	case *int64:
+		to.SetInt64(*from)
+	case uint:
+		to.SetUint64(uint64(from))
+	case *uint:
+		to.SetUint64(uint64(*from))
+	case uint8:
+		to.SetUint64(uint64(from))
+	case *uint8:
+		to.SetUint64(uint64(*from))
+	case uint16:
+		to.SetUint64(uint64(from))
+	case *uint16:
+		to.SetUint64(uint64(*from))
+	case uint32:
+		to.SetUint64(uint64(from))
+	case *uint32:
+		to.SetUint64(uint64(*from))
+	case uint64:
+		to.SetUint64(from)
+	case *uint64:
+		to.SetUint64(*from)
+	case float32:
+		return setFloat(float64(from))
+	case *float32:
+		return setFloat(float64(*from))
+	case float64:
+		return setFloat(from)
+	case *float64:
+		return setFloat(*from)
+	case bool:
+		to.SetInt64(boolToInt64(from))
+	case *bool:
+		to.SetInt64(boolToInt64(*from))
+	case string:
+		return setString(from)
+	case *string:
+		return setString(*from)
+	case []byte:
+		return setString(string(from))
+	case json.Number:
+		return setString(string(from))
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToBigRat(v, to)
+	default:
+		return newErrorCast(from, to)
+	}
+	return nil
+}
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// castToBig casts an interface to a big.Int, big.Float or big.Rat destination.
+// It returns false if the destination is not a big number.
+func castToBig(from any, to any) (bool, error) {
+	switch to := to.(type) {
+	case *big.Int:
+		return true, ToBigInt(from, to)
+	case *big.Float:
+		return true, ToBigFloat(from, to)
+	case *big.Rat:
+		return true, ToBigRat(from, to)
+	}
+	return false, nil
+}
+
+// fromBig casts a big.Int, big.Float or big.Rat value to the destination with the exact range checks.
+// A big.Rat value which is not an integer cannot be cast to an integer type, while a big.Float value is truncated like a float.
+func fromBig(from any, to any) error {
+	if isNil(from) {
+		return newErrorNil(from, to)
+	}
+
+	switch to := to.(type) {
+	case *big.Int:
+		switch from := from.(type) {
+		case *big.Int:
+			to.Set(from)
+		case *big.Float:
+			if from.IsInf() {
+				return newErrorInfinity(from, to)
+			}
+			from.Int(to)
+		case *big.Rat:
+			if !from.IsInt() {
+				return newErrorFractional(from, to)
+			}
+			to.Set(from.Num())
+		}
+		return nil
+	case *big.Float:
+		switch from := from.(type) {
+		case *big.Int:
+			to.SetInt(from)
+		case *big.Float:
+			to.Set(from)
+		case *big.Rat:
+			to.SetRat(from)
+		}
+		return nil
+	case *big.Rat:
+		switch from := from.(type) {
+		case *big.Int:
+			to.SetInt(from)
+		case *big.Float:
+			if from.IsInf() {
+				return newErrorInfinity(from, to)
+			}
+			from.Rat(to)
+		case *big.Rat:
+			to.Set(from)
+		}
+		return nil
+	case *string:
+		return ToString(from, to)
+	case *[]byte:
+		return ToBytes(from, to)
+	case *float32, *float64:
+		var f float64
+		switch from := from.(type) {
+		case *big.Int:
+			f, _ = new(big.Float).SetInt(from).Float64()
+		case *big.Float:
+			f, _ = from.Float64()
+			if from.IsInf() {
+				return FromFloat64(f, to)
+			}
+		case *big.Rat:
+			f, _ = from.Float64()
+		}
+		if math.IsInf(f, 1) {
+			return newErrorOverRange(from, to)
+		}
+		if math.IsInf(f, -1) {
+			return newErrorUnderRange(from, to)
+		}
+		return FromFloat64(f, to)
+	}
+
+	var i *big.Int
+	switch from := from.(type) {
+	case *big.Int:
+		i = from
+	case *big.Float:
+		if from.IsInf() {
+			return newErrorInfinity(from, to)
+		}
+		i, _ = from.Int(nil)
+	case *big.Rat:
+		if !from.IsInt() {
+			return newErrorFractional(from, to)
+		}
+		i = from.Num()
+	}
+	switch {
+	case i.IsInt64():
+		return FromInt64(i.Int64(), to)
+	case i.IsUint64():
+		return FromUint64(i.Uint64(), to)
+	case 0 < i.Sign():
+		return newErrorOverRange(from, to)
+	default:
+		return newErrorUnderRange(from, to)
+	}
+}
@@ -15,7 +15,9 @@
 package safecast
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"strconv"
 )
 
@@ -78,7 +80,7 @@ func ToBool(from any, to *bool) error {
 	parseBool := func(s string) (bool, error) {
 		b, err := strconv.ParseBool(s)
 		if err != nil {
-			return false, newErrorCast(from, to)
+			return false, newErrorSyntax(from, to)
 		}
 		return b, nil
 	}
@@ -141,6 +143,14 @@ func ToBool(from any, to *bool) error {
 		if *to, err = parseBool(string(from)); err != nil {
 			return err
 		}
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToBool(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -14,7 +14,12 @@
 
 package safecast
 
+import (
+	"database/sql/driver"
+)
+
 // FromBytes casts an interface to a byte slice type.
+// A destination implementing encoding.TextUnmarshaler is filled with UnmarshalText.
 func FromBytes(from []byte, to any) error {
 	switch to := to.(type) {
 	case *string:
@@ -22,12 +27,16 @@ func FromBytes(from []byte, to any) error {
 	case *[]byte:
 		*to = from
 	default:
+		if ok, err := unmarshalText(from, to); ok {
+			return err
+		}
 		return newErrorCast(from, to)
 	}
 	return nil
 }
 
 // ToBytes casts an interface to a byte slice type.
+// A value implementing encoding.TextMarshaler is cast with MarshalText.
 func ToBytes(from any, to *[]byte) error {
 	switch from := from.(type) {
 	case string:
@@ -36,8 +45,21 @@ func ToBytes(from any, to *[]byte) error {
 		*to = []byte(*from)
 	case []byte:
 		*to = from
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToBytes(v, to)
 	default:
-		return newErrorCast(from, to)
+		b, ok, err := marshalText(from)
+		if !ok {
+			return newErrorCast(from, to)
+		}
+		if err != nil {
+			return newErrorWithError(err, from, to)
+		}
+		*to = b
 	}
 	return nil
 }
@@ -0,0 +1,69 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"time"
+)
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Castable is a constraint that permits any type supported by Cast.
+type Castable interface {
+	Number | ~string | ~bool | time.Time | []byte
+}
+
+// Cast casts an interface to the type parameter T.
+// The conversion is dispatched to To, so the range checks are identical to the To*() functions.
+func Cast[T Castable](from any, opts ...Option) (T, error) {
+	var to T
+	if err := ToWith(from, &to, opts...); err != nil {
+		var zero T
+		return zero, err
+	}
+	return to, nil
+}
+
+// MustCast casts an interface to the type parameter T, and panics if the conversion fails.
+func MustCast[T Castable](from any, opts ...Option) T {
+	to, err := Cast[T](from, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return to
+}
+
+// CastOr casts an interface to the type parameter T, and returns the fallback value if the conversion fails.
+func CastOr[T Castable](from any, fallback T, opts ...Option) T {
+	to, err := Cast[T](from, opts...)
+	if err != nil {
+		return fallback
+	}
+	return to
+}
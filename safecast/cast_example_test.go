@@ -0,0 +1,56 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleCast() {
+	v, err := Cast[int8]("123")
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println(v)
+	}
+
+	_, err = Cast[int8](math.MaxInt8 + 1)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	s, _ := Cast[string](3.14)
+	fmt.Println(s)
+
+	// Output:
+	// 123
+	// cast error : out of range 128 > *int8
+	// 3.14
+}
+
+func ExampleMustCast() {
+	fmt.Println(MustCast[uint16]("65535"))
+	// Output:
+	// 65535
+}
+
+func ExampleCastOr() {
+	fmt.Println(CastOr[uint8](-1, 0))
+	fmt.Println(CastOr[uint8](255, 0))
+	// Output:
+	// 0
+	// 255
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"reflect"
+	"time"
+)
+
+// Caster casts values with its own set of options.
+// The package-level functions such as To, From, Compare and Equal behave as a Caster without options.
+// A Caster is immutable and safe for concurrent use.
+type Caster struct {
+	cfg *config
+}
+
+// NewCaster returns a new Caster with the specified options.
+func NewCaster(opts ...Option) *Caster {
+	return &Caster{
+		cfg: newConfig(opts...),
+	}
+}
+
+// To casts an interface to an interface type with the options of the caster.
+func (c *Caster) To(from any, to any) error {
+	return c.cfg.to(from, to)
+}
+
+// From casts an interface to an interface type with the options of the caster.
+func (c *Caster) From(from any, to any) error {
+	return c.cfg.from(from, to)
+}
+
+// Compare compares two values with the options of the caster.
+func (c *Caster) Compare(v1 any, v2 any) (int, error) {
+	v1, v2, err := c.cfg.normalizeOperands(v1, v2)
+	if err != nil {
+		return 0, err
+	}
+	return c.cfg.compare(v1, v2)
+}
+
+// Equal checks if two values are equal with the options of the caster.
+func (c *Caster) Equal(v1 any, v2 any) bool {
+	if reflect.DeepEqual(v1, v2) {
+		return true
+	}
+	v1, v2, err := c.cfg.normalizeOperands(v1, v2)
+	if err != nil {
+		return false
+	}
+	return c.cfg.equal(v1, v2)
+}
+
+// normalizeOperands applies the configuration to the operands of Compare and Equal.
+func (cfg *config) normalizeOperands(v1 any, v2 any) (any, any, error) {
+	v1, v2, err := cfg.converters.comparables(v1, v2)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.nilAsZero {
+		v1, v2 = cfg.nilToZero(v1, v2), cfg.nilToZero(v2, v1)
+	}
+	v1, v2 = cfg.normalizeString(v1), cfg.normalizeString(v2)
+	v1, err = cfg.normalizeOperand(v1, v2)
+	if err != nil {
+		return nil, nil, err
+	}
+	v2, err = cfg.normalizeOperand(v2, v1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v1, v2, nil
+}
+
+// nilToZero returns the zero value of the other operand type if the operand is nil.
+func (cfg *config) nilToZero(v any, other any) any {
+	if !isNull(v) || isNull(other) {
+		return v
+	}
+	t := reflect.TypeOf(other)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return reflect.Zero(t).Interface()
+}
+
+// normalizeOperand casts an operand to the type of the other operand when the type requires
+// the options of the caster, such as bool and time.Time.
+func (cfg *config) normalizeOperand(v any, other any) (any, error) {
+	switch other.(type) {
+	case bool, *bool:
+		if !cfg.strictBoolParsing {
+			return v, nil
+		}
+		var b bool
+		from, err := cfg.parseStrictBool(v, &b)
+		if err != nil {
+			return nil, err
+		}
+		return from, nil
+	case time.Time, *time.Time:
+		if len(cfg.timeLayouts) == 0 && cfg.location == nil && cfg.timeUnit == 0 && cfg.zoneAbbrs == nil {
+			return v, nil
+		}
+		switch v.(type) {
+		case string, *string, []byte:
+			var t time.Time
+			if err := cfg.toTime(v, &t); err != nil {
+				return nil, err
+			}
+			return t, nil
+		}
+	}
+	return v, nil
+}
@@ -16,12 +16,61 @@ package safecast
 
 import (
 	"bytes"
+	"cmp"
+	"math"
+	"math/big"
+	"reflect"
 	"strings"
 	"time"
 )
 
 // Compare checks if two values are equal.
+// The result is antisymmetric, so Compare(v1, v2) always equals -Compare(v2, v1).
+// Values of different types are compared in the domain of the type with the higher priority:
+// time.Time, numbers, bool, string and []byte in that order.
+// Numeric values are compared exactly in a common domain regardless of their types, such as int8(1) and 300, or -1 and uint64(1).
+// The values of the types registered by RegisterConverter are converted before they are compared.
 func Compare(v1 any, v2 any) (int, error) {
+	return defaultConfig.compare(v1, v2)
+}
+
+// compare compares two values with the configuration. Numeric values are compared exactly without the options,
+// while the options such as WithRoundingMode and WithTimeLayouts apply when an operand of another type, such as a string,
+// is cast to the type of the other operand. For example, Equal(3, "2.5") is true with RoundHalfAwayFromZero.
+func (cfg *config) compare(v1 any, v2 any) (int, error) {
+	v1, v2, err := cfg.converters.comparables(v1, v2)
+	if err != nil {
+		return 0, err
+	}
+	v1, v2, err = defaultConverters.comparables(v1, v2)
+	if err != nil {
+		return 0, err
+	}
+
+	if r, ok := compareDurations(v1, v2); ok {
+		return r, nil
+	}
+
+	if r, ok := compareNumbers(v1, v2); ok {
+		return r, nil
+	}
+
+	if isNilPointer(v1) && isNilPointer(v2) {
+		return 0, nil
+	}
+
+	if v, ok := toUnderlyingBuiltin(v1); ok {
+		v1 = v
+	}
+	if v, ok := toUnderlyingBuiltin(v2); ok {
+		v2 = v
+	}
+
+	if swapOperands(v1, v2) {
+		r, err := cfg.compare(v2, v1)
+		return -r, err
+	}
+
 	cmp := func(v1, v2 any) (int, error) {
 		cmpInt := func(v1 *int, v2 any) (int, error) {
 			if v1 == nil {
@@ -31,7 +80,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 int
-			if err := ToInt(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToInt); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -51,7 +100,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 int8
-			if err := ToInt8(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToInt8); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -71,7 +120,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 int16
-			if err := ToInt16(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToInt16); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -91,7 +140,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 int32
-			if err := ToInt32(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToInt32); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -111,7 +160,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 int64
-			if err := ToInt64(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToInt64); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -131,7 +180,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 uint
-			if err := ToUint(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToUint); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -151,7 +200,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 uint8
-			if err := ToUint8(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToUint8); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -171,7 +220,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 uint16
-			if err := ToUint16(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToUint16); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -191,7 +240,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 uint32
-			if err := ToUint32(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToUint32); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -211,7 +260,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 uint64
-			if err := ToUint64(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToUint64); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -231,7 +280,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 float32
-			if err := ToFloat32(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToFloat32); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -251,7 +300,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 float64
-			if err := ToFloat64(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToFloat64); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -271,7 +320,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 bool
-			if err := ToBool(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToBool); err != nil {
 				return 0, err
 			}
 			if cv2 == *v1 {
@@ -291,7 +340,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 string
-			if err := ToString(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToString); err != nil {
 				return 0, err
 			}
 			return strings.Compare(*v1, cv2), nil
@@ -305,10 +354,21 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 []byte
-			if err := ToBytes(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, ToBytes); err != nil {
 				return 0, err
 			}
-			return bytes.Compare(cv2, v1), nil
+			return bytes.Compare(v1, cv2), nil
+		}
+
+		cmpBig := func(v1 any, v2 any) (int, error) {
+			var r1, r2 big.Rat
+			if err := ToBigRat(v1, &r1); err != nil {
+				return 0, err
+			}
+			if err := ToBigRat(v2, &r2); err != nil {
+				return 0, err
+			}
+			return r1.Cmp(&r2), nil
 		}
 
 		cmpTime := func(v1 *time.Time, v2 any) (int, error) {
@@ -319,7 +379,7 @@ func Compare(v1 any, v2 any) (int, error) {
 				return -1, nil
 			}
 			var cv2 time.Time
-			if err := ToTime(v2, &cv2); err != nil {
+			if err := castOperand(cfg, v2, &cv2, func(from any, to *time.Time) error { return ToTime(from, to) }); err != nil {
 				return 0, err
 			}
 			if v1.Equal(cv2) {
@@ -390,6 +450,8 @@ func Compare(v1 any, v2 any) (int, error) {
 			return cmpString(v1, v2)
 		case []byte:
 			return cmpBytes(v1, v2)
+		case *big.Int, *big.Float, *big.Rat:
+			return cmpBig(v1, v2)
 		case time.Time:
 			return cmpTime(&v1, v2)
 		case *time.Time:
@@ -409,5 +471,299 @@ func Compare(v1 any, v2 any) (int, error) {
 		return r, nil
 	}
 
-	return cmp(v2, v1)
+	r, err = cmp(v2, v1)
+	if err != nil {
+		return 0, err
+	}
+	return -r, nil
+}
+
+// castOperand casts an operand into the type of the other operand with the configuration,
+// or with the To* function directly if the configuration has no options.
+func castOperand[T any](cfg *config, from any, to *T, cast func(any, *T) error) error {
+	if !cfg.hasOptions {
+		return cast(from, to)
+	}
+	return cfg.to(from, to)
+}
+
+// isNilPointer returns true if the value is a typed nil pointer.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.IsValid() && rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// swapOperands returns true if the operands should be compared in the reverse order.
+// It returns true for exactly one of the two orders of the different operands, so Compare is antisymmetric.
+// A nil pointer is compared first, and otherwise the value with the higher priority is compared first.
+func swapOperands(v1 any, v2 any) bool {
+	nil1, nil2 := isNilPointer(v1), isNilPointer(v2)
+	if nil1 != nil2 {
+		return nil2
+	}
+	return comparePriority(v1) < comparePriority(v2)
+}
+
+const (
+	priorityOther = iota
+	priorityBytes
+	priorityString
+	priorityBool
+	priorityNumber
+	priorityTime
+)
+
+// comparePriority returns the priority of the comparison domain of the value type.
+// A value is converted into the type of the other value when the other value has a higher priority.
+func comparePriority(v any) int {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return priorityOther
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return priorityTime
+	case reflect.TypeOf(big.Int{}), reflect.TypeOf(big.Float{}), reflect.TypeOf(big.Rat{}):
+		return priorityNumber
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return priorityNumber
+	case reflect.Bool:
+		return priorityBool
+	case reflect.String:
+		return priorityString
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return priorityBytes
+		}
+	}
+	return priorityOther
+}
+
+type numberKind int
+
+const (
+	numberInt numberKind = iota
+	numberUint
+	numberFloat
+	numberBig
+)
+
+// number is a numeric operand of Compare.
+type number struct {
+	kind    numberKind
+	i       int64
+	u       uint64
+	f       float64
+	float32 bool
+	big     any
+}
+
+// toNumber converts a numeric value, a pointer to it, a defined numeric type or a big number into a number.
+// It returns false if the value is not numeric or is a nil pointer.
+func toNumber(v any) (number, bool) {
+	if isNil(v) {
+		return number{}, false
+	}
+	if bv, ok := toUnderlyingBuiltin(v); ok {
+		v = bv
+	}
+	switch v := v.(type) {
+	case int:
+		return number{kind: numberInt, i: int64(v)}, true
+	case *int:
+		return number{kind: numberInt, i: int64(*v)}, true
+	case int8:
+		return number{kind: numberInt, i: int64(v)}, true
+	case *int8:
+		return number{kind: numberInt, i: int64(*v)}, true
+	case int16:
+		return number{kind: numberInt, i: int64(v)}, true
+	case *int16:
+		return number{kind: numberInt, i: int64(*v)}, true
+	case int32:
+		return number{kind: numberInt, i: int64(v)}, true
+	case *int32:
+		return number{kind: numberInt, i: int64(*v)}, true
+	case int64:
+		return number{kind: numberInt, i: v}, true
+	case *int64:
+		return number{kind: numberInt, i: *v}, true
+	case uint:
+		return number{kind: numberUint, u: uint64(v)}, true
+	case *uint:
+		return number{kind: numberUint, u: uint64(*v)}, true
+	case uint8:
+		return number{kind: numberUint, u: uint64(v)}, true
+	case *uint8:
+		return number{kind: numberUint, u: uint64(*v)}, true
+	case uint16:
+		return number{kind: numberUint, u: uint64(v)}, true
+	case *uint16:
+		return number{kind: numberUint, u: uint64(*v)}, true
+	case uint32:
+		return number{kind: numberUint, u: uint64(v)}, true
+	case *uint32:
+		return number{kind: numberUint, u: uint64(*v)}, true
+	case uint64:
+		return number{kind: numberUint, u: v}, true
+	case *uint64:
+		return number{kind: numberUint, u: *v}, true
+	case float32:
+		return number{kind: numberFloat, f: float64(v), float32: true}, true
+	case *float32:
+		return number{kind: numberFloat, f: float64(*v), float32: true}, true
+	case float64:
+		return number{kind: numberFloat, f: v}, true
+	case *float64:
+		return number{kind: numberFloat, f: *v}, true
+	case *big.Int, *big.Float, *big.Rat:
+		return number{kind: numberBig, big: v}, true
+	}
+	return number{}, false
+}
+
+// bigFloat returns the number as a big.Float exactly.
+func (n number) bigFloat() *big.Float {
+	switch n.kind {
+	case numberInt:
+		return new(big.Float).SetInt64(n.i)
+	case numberUint:
+		return new(big.Float).SetUint64(n.u)
+	case numberFloat:
+		return new(big.Float).SetFloat64(n.f)
+	}
+	switch v := n.big.(type) {
+	case *big.Int:
+		return new(big.Float).SetInt(v)
+	case *big.Float:
+		return v
+	}
+	return nil
+}
+
+// infinity returns the sign of the infinity, or zero if the number is finite.
+func (n number) infinity() int {
+	switch n.kind {
+	case numberFloat:
+		if math.IsInf(n.f, 0) {
+			return int(math.Copysign(1, n.f))
+		}
+	case numberBig:
+		if v, ok := n.big.(*big.Float); ok && v.IsInf() {
+			return v.Sign()
+		}
+	}
+	return 0
+}
+
+// bigRat returns the finite number as a big.Rat exactly.
+func (n number) bigRat() *big.Rat {
+	if r, ok := n.big.(*big.Rat); ok {
+		return r
+	}
+	r, _ := n.bigFloat().Rat(nil)
+	return r
+}
+
+// isRat returns true if the number is a big.Rat.
+func (n number) isRat() bool {
+	_, ok := n.big.(*big.Rat)
+	return ok
+}
+
+// compareNumbers compares two numeric values exactly. Signed and unsigned integers are compared with their signs,
+// and the other combinations are compared as big numbers. When a float32 value is compared with a float64 value,
+// both values are rounded to float32 first, so Compare(float32(0.1), 0.1) returns 0 as in the previous releases.
+// NaN is equal to NaN and less than any other number like cmp.Compare.
+// It returns false if either value is not numeric.
+func compareNumbers(v1 any, v2 any) (int, bool) {
+	n1, ok := toNumber(v1)
+	if !ok {
+		return 0, false
+	}
+	n2, ok := toNumber(v2)
+	if !ok {
+		return 0, false
+	}
+	nan1, nan2 := n1.kind == numberFloat && math.IsNaN(n1.f), n2.kind == numberFloat && math.IsNaN(n2.f)
+	if nan1 || nan2 {
+		switch {
+		case nan1 && nan2:
+			return 0, true
+		case nan1:
+			return -1, true
+		}
+		return 1, true
+	}
+
+	switch {
+	case n1.kind == numberInt && n2.kind == numberInt:
+		return cmp.Compare(n1.i, n2.i), true
+	case n1.kind == numberUint && n2.kind == numberUint:
+		return cmp.Compare(n1.u, n2.u), true
+	case n1.kind == numberInt && n2.kind == numberUint:
+		if n1.i < 0 {
+			return -1, true
+		}
+		return cmp.Compare(uint64(n1.i), n2.u), true
+	case n1.kind == numberUint && n2.kind == numberInt:
+		if n2.i < 0 {
+			return 1, true
+		}
+		return cmp.Compare(n1.u, uint64(n2.i)), true
+	case n1.kind == numberFloat && n2.kind == numberFloat:
+		f1, f2 := n1.f, n2.f
+		if n1.float32 != n2.float32 {
+			f1, f2 = float64(float32(f1)), float64(float32(f2))
+		}
+		return cmp.Compare(f1, f2), true
+	}
+
+	if !n1.isRat() && !n2.isRat() {
+		return n1.bigFloat().Cmp(n2.bigFloat()), true
+	}
+	inf1, inf2 := n1.infinity(), n2.infinity()
+	if inf1 != 0 || inf2 != 0 {
+		return cmp.Compare(inf1, inf2), true
+	}
+	return n1.bigRat().Cmp(n2.bigRat()), true
+}
+
+// compareDurations compares a time.Duration with a duration string such as "2s".
+// It returns false if neither value is a time.Duration, or the other value is not a valid duration string.
+func compareDurations(v1 any, v2 any) (int, bool) {
+	toDuration := func(v any) (time.Duration, bool, bool) {
+		switch v := v.(type) {
+		case time.Duration:
+			return v, true, true
+		case *time.Duration:
+			if v != nil {
+				return *v, true, true
+			}
+			return 0, false, false
+		case string, *string, []byte:
+			if isNil(v) {
+				return 0, false, false
+			}
+			var d time.Duration
+			if err := ToDuration(v, &d); err != nil {
+				return 0, false, false
+			}
+			return d, false, true
+		}
+		return 0, false, false
+	}
+	d1, isDuration1, ok1 := toDuration(v1)
+	d2, isDuration2, ok2 := toDuration(v2)
+	if !ok1 || !ok2 || (!isDuration1 && !isDuration2) {
+		return 0, false
+	}
+	return cmp.Compare(d1, d2), true
 }
@@ -0,0 +1,248 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConverterFunc converts a value into a value of the destination type of the converter.
+type ConverterFunc func(from any) (any, error)
+
+type converterKey struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+type converterRegistry struct {
+	sync.RWMutex
+	converters map[converterKey]ConverterFunc
+	// count is the number of the registered converters, which lets the conversions skip the registry without locking.
+	count atomic.Int64
+}
+
+// defaultConverters is the global registry consulted by the package-level functions and all casters.
+var defaultConverters = newConverterRegistry()
+
+// comparableTypes are the builtin types tried in order when a registered type is compared.
+var comparableTypes = []reflect.Type{
+	reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint64(0)),
+	reflect.TypeOf(float64(0)),
+	reflect.TypeOf(""),
+	reflect.TypeOf(false),
+	reflect.TypeOf(time.Time{}),
+	reflect.TypeOf([]byte(nil)),
+}
+
+func newConverterRegistry() *converterRegistry {
+	return &converterRegistry{
+		RWMutex:    sync.RWMutex{},
+		converters: map[converterKey]ConverterFunc{},
+		count:      atomic.Int64{},
+	}
+}
+
+// RegisterConverter registers a global converter from the type parameter From to the type parameter To.
+// The registered converters are consulted by To, From, Compare and Equal before the builtin conversions,
+// and the types must match exactly. A value without a converter to the destination type is cast through
+// the builtin type which has a registered converter. A converter registered later for the same types replaces the former.
+func RegisterConverter[From any, To any](fn func(From) (To, error)) {
+	defaultConverters.register(typeFor[From](), typeFor[To](), genericConverterFunc(fn))
+}
+
+// RegisterConverterFunc registers a global converter from the type to the type.
+// The converter must return a value assignable to the destination type.
+func RegisterConverterFunc(from reflect.Type, to reflect.Type, fn ConverterFunc) {
+	defaultConverters.register(from, to, fn)
+}
+
+// UnregisterConverter removes the global converter from the type parameter From to the type parameter To
+// registered by RegisterConverter or RegisterConverterFunc. It does nothing if no converter is registered.
+func UnregisterConverter[From any, To any]() {
+	defaultConverters.unregister(typeFor[From](), typeFor[To]())
+}
+
+// UnregisterConverterFunc removes the global converter from the type to the type.
+func UnregisterConverterFunc(from reflect.Type, to reflect.Type) {
+	defaultConverters.unregister(from, to)
+}
+
+// WithConverter registers a converter from the type parameter From to the type parameter To
+// only for the conversions with the option. It takes precedence over the global converters.
+func WithConverter[From any, To any](fn func(From) (To, error)) Option {
+	return WithConverterFunc(typeFor[From](), typeFor[To](), genericConverterFunc(fn))
+}
+
+// WithConverterFunc registers a converter from the type to the type only for the conversions with the option.
+func WithConverterFunc(from reflect.Type, to reflect.Type, fn ConverterFunc) Option {
+	return func(cfg *config) {
+		if cfg.converters == nil {
+			cfg.converters = newConverterRegistry()
+		}
+		cfg.converters.register(from, to, fn)
+	}
+}
+
+// isBuiltinType returns true if the type is a builtin type supported by the conversions.
+func isBuiltinType(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	if bt, ok := builtinKindTypes[t.Kind()]; ok {
+		return t == bt
+	}
+	return t == builtinBytesType || t == reflect.TypeOf(time.Time{})
+}
+
+func typeFor[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func genericConverterFunc[From any, To any](fn func(From) (To, error)) ConverterFunc {
+	return func(from any) (any, error) {
+		v, ok := from.(From)
+		if !ok {
+			return nil, newErrorCast(from, new(To))
+		}
+		return fn(v)
+	}
+}
+
+func (reg *converterRegistry) register(from reflect.Type, to reflect.Type, fn ConverterFunc) {
+	reg.Lock()
+	defer reg.Unlock()
+	reg.converters[converterKey{from: from, to: to}] = fn
+	reg.count.Store(int64(len(reg.converters)))
+}
+
+func (reg *converterRegistry) unregister(from reflect.Type, to reflect.Type) {
+	reg.Lock()
+	defer reg.Unlock()
+	delete(reg.converters, converterKey{from: from, to: to})
+	reg.count.Store(int64(len(reg.converters)))
+}
+
+// isEmpty returns true if no converter is registered.
+func (reg *converterRegistry) isEmpty() bool {
+	return reg == nil || reg.count.Load() == 0
+}
+
+func (reg *converterRegistry) lookup(from reflect.Type, to reflect.Type) (ConverterFunc, bool) {
+	if reg.isEmpty() || from == nil || to == nil {
+		return nil, false
+	}
+	reg.RLock()
+	defer reg.RUnlock()
+	fn, ok := reg.converters[converterKey{from: from, to: to}]
+	return fn, ok
+}
+
+// convert converts the value with the registered converter, and returns false if no converter is registered.
+// A pointer source is dereferenced when no converter is registered for the pointer type.
+func (reg *converterRegistry) convert(from any, to reflect.Type) (any, bool, error) {
+	fn, ok := reg.lookup(reflect.TypeOf(from), to)
+	if !ok {
+		fv := reflect.ValueOf(from)
+		if !fv.IsValid() || fv.Kind() != reflect.Pointer || fv.IsNil() {
+			return nil, false, nil
+		}
+		from = fv.Elem().Interface()
+		fn, ok = reg.lookup(fv.Type().Elem(), to)
+		if !ok {
+			return nil, false, nil
+		}
+	}
+	v, err := fn(from)
+	return v, true, err
+}
+
+// cast casts the value into the destination pointer with the registered converter, and returns true if the destination is set.
+// Otherwise, it returns the value converted into a builtin type by a registered converter,
+// or the value as it is, to be cast by the builtin conversions.
+func (reg *converterRegistry) cast(from any, to any) (any, bool, error) {
+	if reg.isEmpty() {
+		return from, false, nil
+	}
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return from, false, nil
+	}
+	v, ok, err := reg.convert(from, tv.Type().Elem())
+	if !ok {
+		v, err = reg.comparable(from, nil)
+		if err != nil {
+			return nil, false, newErrorConverter(err, from, to)
+		}
+		return v, false, nil
+	}
+	if err != nil {
+		return nil, false, newErrorConverter(err, from, to)
+	}
+	vv := reflect.ValueOf(v)
+	if !vv.IsValid() || !vv.Type().AssignableTo(tv.Elem().Type()) {
+		return nil, false, newCastError(ReasonUnsupported, from, to, nil, fmt.Errorf(errorCastType, ErrCast, v, v, to))
+	}
+	tv.Elem().Set(vv)
+	return nil, true, nil
+}
+
+// newErrorConverter wraps an error returned by a registered converter with ErrCast.
+// A CastError returned by the converter keeps its reason, and the other errors are reported as ReasonUnsupported.
+func newErrorConverter(err error, from any, to any) error {
+	var castErr *CastError
+	if errors.As(err, &castErr) {
+		return err
+	}
+	return newCastError(ReasonUnsupported, from, to, err, fmt.Errorf(errorSimple, ErrCast, err.Error()))
+}
+
+// comparables converts the operands of Compare with the registered converters.
+func (reg *converterRegistry) comparables(v1 any, v2 any) (any, any, error) {
+	if reg.isEmpty() {
+		return v1, v2, nil
+	}
+	cv1, err := reg.comparable(v1, v2)
+	if err != nil {
+		return nil, nil, err
+	}
+	cv2, err := reg.comparable(v2, v1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cv1, cv2, nil
+}
+
+// comparable converts a value of a registered type into the builtin type of the other operand,
+// or into the first builtin type which has a registered converter.
+// It returns the value as it is if no converter is registered.
+func (reg *converterRegistry) comparable(v any, other any) (any, error) {
+	if t := reflect.TypeOf(other); isBuiltinType(t) {
+		if cv, ok, err := reg.convert(v, t); ok {
+			return cv, err
+		}
+	}
+	for _, t := range comparableTypes {
+		if cv, ok, err := reg.convert(v, t); ok {
+			return cv, err
+		}
+	}
+	return v, nil
+}
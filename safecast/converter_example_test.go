@@ -0,0 +1,43 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+)
+
+type exampleMoney struct {
+	cents int64
+}
+
+func ExampleRegisterConverter() {
+	RegisterConverter(func(m exampleMoney) (int64, error) {
+		return m.cents, nil
+	})
+	defer UnregisterConverter[exampleMoney, int64]()
+
+	var to int32
+	if err := To(exampleMoney{cents: 1250}, &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if cmp, err := Compare(exampleMoney{cents: 1250}, 1000); err == nil {
+		fmt.Println(cmp)
+	}
+
+	// Output:
+	// 1250
+	// 1
+}
@@ -0,0 +1,249 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ToDuration casts an interface to a time.Duration type.
+// A string is parsed as a Go duration string such as "1h30m", and a plain number, or a numeric string without a unit,
+// is interpreted in the unit which defaults to time.Nanosecond. A numeric string must be a decimal number such as "1.5" or "15e-1",
+// and the other forms such as the fraction "1/3" and the hexadecimal "0x10" are rejected.
+// A float value is converted from its shortest decimal representation, and the fraction of a nanosecond is truncated toward zero.
+func ToDuration(from any, to *time.Duration, unit ...time.Duration) error {
+	u := time.Nanosecond
+	if 0 < len(unit) {
+		u = unit[0]
+	}
+	if u <= 0 {
+		return newErrorCast(from, to)
+	}
+
+	fromInt64 := func(v int64) error {
+		if math.MaxInt64/int64(u) < v {
+			return newErrorOverRange(v, to)
+		}
+		if v < math.MinInt64/int64(u) {
+			return newErrorUnderRange(v, to)
+		}
+		*to = time.Duration(v) * u
+		return nil
+	}
+	fromUint64 := func(v uint64) error {
+		if uint64(math.MaxInt64/int64(u)) < v {
+			return newErrorOverRange(v, to)
+		}
+		*to = time.Duration(v) * u
+		return nil
+	}
+	fromRat := func(r *big.Rat, from any) error {
+		r = new(big.Rat).Mul(r, new(big.Rat).SetInt64(int64(u)))
+		ns := new(big.Int).Quo(r.Num(), r.Denom())
+		if !ns.IsInt64() {
+			if 0 < ns.Sign() {
+				return newErrorOverRange(from, to)
+			}
+			return newErrorUnderRange(from, to)
+		}
+		*to = time.Duration(ns.Int64())
+		return nil
+	}
+	fromFloat64 := func(v float64) error {
+		if math.IsNaN(v) {
+			return newErrorNaN(v, to)
+		}
+		if math.IsInf(v, 0) {
+			return newErrorInfinity(v, to)
+		}
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+		return fromRat(r, v)
+	}
+	fromString := func(s string) error {
+		d, err := time.ParseDuration(s)
+		if err == nil {
+			*to = d
+			return nil
+		}
+		if v, ierr := strconv.ParseInt(s, 10, 64); ierr == nil {
+			return fromInt64(v)
+		}
+		if !isDecimalExponentString(s) {
+			return newErrorWithError(err, s, to)
+		}
+		if r, ok := new(big.Rat).SetString(s); ok {
+			return fromRat(r, s)
+		}
+		return newErrorWithError(err, s, to)
+	}
+
+	switch from := from.(type) {
+	case time.Duration:
+		*to = from
+	case *time.Duration:
+		*to = *from
+	case int:
+		return fromInt64(int64(from))
+	case *int:
+		return fromInt64(int64(*from))
+	case int8:
+		return fromInt64(int64(from))
+	case *int8:
+		return fromInt64(int64(*from))
+	case int16:
+		return fromInt64(int64(from))
+	case *int16:
+		return fromInt64(int64(*from))
+	case int32:
+		return fromInt64(int64(from))
+	case *int32:
+		return fromInt64(int64(*from))
+	case int64:
+		return fromInt64(from)
+	case *int64:
+		return fromInt64(*from)
+	case uint:
+		return fromUint64(uint64(from))
+	case *uint:
+		return fromUint64(uint64(*from))
+	case uint8:
+		return fromUint64(uint64(from))
+	case *uint8:
+		return fromUint64(uint64(*from))
+	case uint16:
+		return fromUint64(uint64(from))
+	case *uint16:
+		return fromUint64(uint64(*from))
+	case uint32:
+		return fromUint64(uint64(from))
+	case *uint32:
+		return fromUint64(uint64(*from))
+	case uint64:
+		return fromUint64(from)
+	case *uint64:
+		return fromUint64(*from)
+	case float32:
+		return fromFloat64(float64(from))
+	case *float32:
+		return fromFloat64(float64(*from))
+	case float64:
+		return fromFloat64(from)
+	case *float64:
+		return fromFloat64(*from)
+	case string:
+		return fromString(from)
+	case *string:
+		return fromString(*from)
+	case []byte:
+		return fromString(string(from))
+	case json.Number:
+		return fromString(string(from))
+	case *big.Int, *big.Float, *big.Rat:
+		var r big.Rat
+		if err := ToBigRat(from, &r); err != nil {
+			return err
+		}
+		return fromRat(&r, from)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToDuration(v, to, u)
+	default:
+		return newErrorCast(from, to)
+	}
+	return nil
+}
+
+// isDecimalExponentString returns true if the string is a decimal number with an optional exponent, such as "-1.5e3".
+func isDecimalExponentString(s string) bool {
+	if i := strings.IndexAny(s, "eE"); 0 <= i {
+		if _, err := strconv.Atoi(s[i+1:]); err != nil {
+			return false
+		}
+		s = s[:i]
+	}
+	return isDecimalString(s)
+}
+
+// durationString returns the duration parsed from a Go duration string such as "1h30m".
+// It returns false if the value is not a string or not a duration string.
+func durationString(from any) (time.Duration, bool) {
+	var s string
+	switch from := from.(type) {
+	case string:
+		s = from
+	case *string:
+		if from == nil {
+			return 0, false
+		}
+		s = *from
+	case []byte:
+		s = string(from)
+	default:
+		return 0, false
+	}
+	d, err := time.ParseDuration(s)
+	return d, err == nil
+}
+
+// FromDuration casts a time.Duration to an interface type.
+// A numeric destination receives the duration in the unit which defaults to time.Nanosecond,
+// and the fraction of the unit is truncated for an integer destination.
+// A string destination receives the duration string such as "1h30m0s".
+func FromDuration(from time.Duration, to any, unit ...time.Duration) error {
+	u := time.Nanosecond
+	if 0 < len(unit) {
+		u = unit[0]
+	}
+	if u <= 0 {
+		return newErrorCast(from, to)
+	}
+
+	switch to := to.(type) {
+	case *time.Duration:
+		*to = from
+	case *string:
+		*to = from.String()
+	case *[]byte:
+		*to = []byte(from.String())
+	case *float32, *float64:
+		return FromFloat64(float64(from)/float64(u), to)
+	case *big.Int, *big.Float, *big.Rat:
+		r := big.NewRat(int64(from), int64(u))
+		if v, ok := to.(*big.Int); ok {
+			v.Quo(r.Num(), r.Denom())
+			return nil
+		}
+		_, err := castToBig(r, to)
+		return err
+	default:
+		cast := func(_ any, to any) error {
+			return FromDuration(from, to, u)
+		}
+		if ok, err := castToUnderlyingBuiltin(from, to, cast); ok {
+			return err
+		}
+		return FromInt64(int64(from/u), to)
+	}
+	return nil
+}
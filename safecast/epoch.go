@@ -0,0 +1,152 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+var (
+	minUnixSeconds = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxUnixSeconds = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+// ToUnixTime casts a Unix epoch number to a time.Time in UTC.
+// The number is interpreted in the unit, such as time.Second, time.Millisecond, time.Microsecond or time.Nanosecond.
+// If the unit is omitted, it is detected from the magnitude of the number: seconds below 1e11, milliseconds below 1e14,
+// microseconds below 1e17 and nanoseconds otherwise. A float value is converted from its shortest decimal representation,
+// and the fraction of a nanosecond is truncated toward zero. An error is returned if the time is not between
+// the years 1 and 9999.
+func ToUnixTime(from any, to *time.Time, unit ...time.Duration) error {
+	var u time.Duration
+	if 0 < len(unit) {
+		u = unit[0]
+		if u <= 0 {
+			return newErrorCast(from, to)
+		}
+	}
+	return toUnixTime(from, to, nil, u)
+}
+
+// toUnixTime casts a Unix epoch number to a time.Time in the location, or in UTC if the location is nil.
+// The unit is detected from the magnitude of the number if it is zero.
+func toUnixTime(from any, to *time.Time, loc *time.Location, unit time.Duration) error {
+	r, err := unixRat(from, to)
+	if err != nil {
+		return err
+	}
+	switch {
+	case unit < 0:
+		return newErrorCast(from, to)
+	case unit == 0:
+		unit = detectUnixUnit(r)
+	}
+	r = new(big.Rat).Mul(r, new(big.Rat).SetInt64(int64(unit)))
+	ns := new(big.Int).Quo(r.Num(), r.Denom())
+	sec, nsec := new(big.Int).DivMod(ns, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() || sec.Int64() < minUnixSeconds || maxUnixSeconds < sec.Int64() {
+		if 0 < sec.Sign() {
+			return newErrorOverRange(from, to)
+		}
+		return newErrorUnderRange(from, to)
+	}
+	t := time.Unix(sec.Int64(), nsec.Int64()).UTC()
+	if loc != nil {
+		t = t.In(loc)
+	}
+	*to = t
+	return nil
+}
+
+// unixRat returns the exact value of a Unix epoch number.
+func unixRat(from any, to *time.Time) (*big.Rat, error) {
+	fromFloat := func(v float64, bitSize int) (*big.Rat, error) {
+		if math.IsNaN(v) {
+			return nil, newErrorNaN(v, to)
+		}
+		if math.IsInf(v, 0) {
+			return nil, newErrorInfinity(v, to)
+		}
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, bitSize))
+		return r, nil
+	}
+	fromString := func(s string) (*big.Rat, error) {
+		if !isDecimalString(s) {
+			return nil, newErrorSyntax(s, to)
+		}
+		r, _ := new(big.Rat).SetString(s)
+		return r, nil
+	}
+	switch v := from.(type) {
+	case float32:
+		return fromFloat(float64(v), 32)
+	case *float32:
+		return fromFloat(float64(*v), 32)
+	case float64:
+		return fromFloat(v, 64)
+	case *float64:
+		return fromFloat(*v, 64)
+	case string:
+		return fromString(v)
+	case *string:
+		return fromString(*v)
+	case []byte:
+		return fromString(string(v))
+	case bool, *bool:
+		return nil, newErrorCast(from, to)
+	}
+	r := new(big.Rat)
+	if err := ToBigRat(from, r); err != nil {
+		return nil, newErrorCast(from, to)
+	}
+	return r, nil
+}
+
+// detectUnixUnit returns the unit of a Unix epoch number from its magnitude.
+func detectUnixUnit(r *big.Rat) time.Duration {
+	abs := new(big.Int).Quo(new(big.Int).Abs(r.Num()), r.Denom())
+	switch {
+	case abs.Cmp(big.NewInt(1e11)) < 0:
+		return time.Second
+	case abs.Cmp(big.NewInt(1e14)) < 0:
+		return time.Millisecond
+	case abs.Cmp(big.NewInt(1e17)) < 0:
+		return time.Microsecond
+	}
+	return time.Nanosecond
+}
+
+// isDecimalString returns true if the string is a decimal number with an optional sign and fraction, such as "-1700000000.123".
+func isDecimalString(s string) bool {
+	if 0 < len(s) && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	digits := 0
+	dot := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			digits++
+		case c == '.' && !dot:
+			dot = true
+		default:
+			return false
+		}
+	}
+	return 0 < digits
+}
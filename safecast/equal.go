@@ -21,6 +21,11 @@ import (
 // Equal checks if two values are equal.
 // It returns true if both values are equal, otherwise false.
 func Equal(v1 any, v2 any) bool {
+	return defaultConfig.equal(v1, v2)
+}
+
+// equal checks if two values are equal with the configuration.
+func (cfg *config) equal(v1 any, v2 any) bool {
 	if reflect.DeepEqual(v1, v2) {
 		return true
 	}
@@ -30,7 +35,7 @@ func Equal(v1 any, v2 any) bool {
 			return false
 		}
 		for i := range v1 {
-			if !Equal(v1[i], v2[i]) {
+			if !cfg.equal(v1[i], v2[i]) {
 				return false
 			}
 		}
@@ -46,7 +51,7 @@ func Equal(v1 any, v2 any) bool {
 			if !ok {
 				return false
 			}
-			if !Equal(v1Val, v2Val) {
+			if !cfg.equal(v1Val, v2Val) {
 				return false
 			}
 		}
@@ -85,7 +90,7 @@ func Equal(v1 any, v2 any) bool {
 		return false
 	}
 
-	cmp, err := Compare(v1, v2)
+	cmp, err := cfg.compare(v1, v2)
 	if err == nil {
 		switch cmp {
 		case 0:
@@ -17,38 +17,231 @@ package safecast
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 // ErrCast is returned when a value cannot be cast to the desired type.
 var ErrCast = errors.New("cast error")
 
+// ErrOverflow is returned when a value is greater than the maximum value of the destination type.
+var ErrOverflow = errors.New("overflow")
+
+// ErrUnderflow is returned when a value is less than the minimum value of the destination type.
+var ErrUnderflow = errors.New("underflow")
+
+// ErrSyntax is returned when a value cannot be parsed as the destination type.
+var ErrSyntax = errors.New("syntax error")
+
+// ErrUnsupportedType is returned when the conversion between the source and destination types is not supported.
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// ErrFractional is returned when a float value which has a non-zero fractional part cannot be cast to an integer type exactly.
+var ErrFractional = errors.New("fractional")
+
+// ErrNaN is returned when a NaN value is cast to a type which cannot represent it.
+var ErrNaN = errors.New("NaN")
+
+// ErrInfinity is returned when an infinite value is cast to a type which cannot represent it.
+var ErrInfinity = errors.New("infinity")
+
+// ErrPrecisionLoss is returned when a value cannot be represented by the destination float type exactly.
+var ErrPrecisionLoss = errors.New("precision loss")
+
+// ErrFloatUnderflow is returned when a non-zero value is rounded to zero by the destination float type with WithFloatUnderflowError.
+var ErrFloatUnderflow = errors.New("float underflow")
+
 // ErrNil is returned when a nil value is passed to a casting function that requires a non-nil value.
 var ErrNil = errors.New("nil")
 
+// Reason represents the reason why a value cannot be cast.
+type Reason int
+
+const (
+	// ReasonUnsupported means that the conversion between the types is not supported.
+	ReasonUnsupported Reason = iota
+	// ReasonOverflow means that the value is greater than the maximum value of the destination type.
+	ReasonOverflow
+	// ReasonUnderflow means that the value is less than the minimum value of the destination type.
+	ReasonUnderflow
+	// ReasonSyntax means that the value cannot be parsed as the destination type.
+	ReasonSyntax
+	// ReasonFractional means that the value has a non-zero fractional part.
+	ReasonFractional
+	// ReasonNil means that the value is nil.
+	ReasonNil
+	// ReasonNaN means that the value is NaN.
+	ReasonNaN
+	// ReasonInfinity means that the value is an infinity.
+	ReasonInfinity
+	// ReasonPrecisionLoss means that the value cannot be represented by the destination type exactly.
+	ReasonPrecisionLoss
+	// ReasonFloatUnderflow means that the non-zero value is rounded to zero by the destination float type.
+	ReasonFloatUnderflow
+)
+
+// String returns the string representation of the reason.
+func (reason Reason) String() string {
+	switch reason {
+	case ReasonUnsupported:
+		return "unsupported"
+	case ReasonOverflow:
+		return "overflow"
+	case ReasonUnderflow:
+		return "underflow"
+	case ReasonSyntax:
+		return "syntax"
+	case ReasonFractional:
+		return "fractional"
+	case ReasonNil:
+		return "nil"
+	case ReasonNaN:
+		return "NaN"
+	case ReasonInfinity:
+		return "infinity"
+	case ReasonPrecisionLoss:
+		return "precision loss"
+	case ReasonFloatUnderflow:
+		return "float underflow"
+	default:
+		return "unknown"
+	}
+}
+
+// CastError represents an error which occurred when a value is cast to another type.
+// CastError wraps ErrCast, the sentinel error of the reason, and the underlying error such as *strconv.NumError,
+// so errors.Is and errors.As work for all of them.
+type CastError struct {
+	// From is the source value.
+	From any
+	// FromType is the type of the source value, or nil if the source value is nil.
+	FromType reflect.Type
+	// ToType is the destination type. A pointer destination is represented by the pointed type.
+	ToType reflect.Type
+	// Reason is the reason why the value cannot be cast.
+	Reason Reason
+	// Err is the underlying error, or nil if there is no underlying error.
+	Err error
+	msg string
+}
+
+// Error returns the error message.
+func (e *CastError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the errors wrapped by the error.
+func (e *CastError) Unwrap() []error {
+	errs := []error{ErrCast}
+	switch e.Reason {
+	case ReasonUnsupported:
+		errs = append(errs, ErrUnsupportedType)
+	case ReasonOverflow:
+		errs = append(errs, ErrOverflow)
+	case ReasonUnderflow:
+		errs = append(errs, ErrUnderflow)
+	case ReasonSyntax:
+		errs = append(errs, ErrSyntax)
+	case ReasonFractional:
+		errs = append(errs, ErrFractional)
+	case ReasonNil:
+		errs = append(errs, ErrNil)
+	case ReasonNaN:
+		errs = append(errs, ErrNaN)
+	case ReasonInfinity:
+		errs = append(errs, ErrInfinity)
+	case ReasonPrecisionLoss:
+		errs = append(errs, ErrPrecisionLoss)
+	case ReasonFloatUnderflow:
+		errs = append(errs, ErrFloatUnderflow)
+	}
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	return errs
+}
+
 const (
 	errorCastType   = "%w : %T (%v) => %T"
 	errorOverRange  = "%w : out of range %v > %T"
 	errorUnderRange = "%w : out of range %v < %T"
 	errorSimple     = "%w : %s"
 	errorCompare    = "%w : %T (%v) != %T (%v)"
+	errorReason     = "%w : %w %v => %T"
 )
 
+func newCastError(reason Reason, fromItem any, toItem any, err error, msg error) *CastError {
+	toType := reflect.TypeOf(toItem)
+	if toType != nil && toType.Kind() == reflect.Pointer {
+		toType = toType.Elem()
+	}
+	return &CastError{
+		From:     fromItem,
+		FromType: reflect.TypeOf(fromItem),
+		ToType:   toType,
+		Reason:   reason,
+		Err:      err,
+		msg:      msg.Error(),
+	}
+}
+
 func newErrorCast(fromItem any, toItem any) error {
-	return fmt.Errorf(errorCastType, ErrCast, fromItem, fromItem, toItem)
+	reason := ReasonUnsupported
+	if fromItem == nil {
+		reason = ReasonNil
+	}
+	return newCastError(reason, fromItem, toItem, nil, fmt.Errorf(errorCastType, ErrCast, fromItem, fromItem, toItem))
+}
+
+func newErrorSyntax(fromItem any, toItem any) error {
+	return newCastError(ReasonSyntax, fromItem, toItem, nil, fmt.Errorf(errorCastType, ErrCast, fromItem, fromItem, toItem))
+}
+
+func newErrorNil(fromItem any, toItem any) error {
+	return newCastError(ReasonNil, fromItem, toItem, nil, fmt.Errorf(errorCastType, ErrCast, fromItem, fromItem, toItem))
 }
 
 func newErrorOverRange(fromItem any, toItem any) error {
-	return fmt.Errorf(errorOverRange, ErrCast, fromItem, toItem)
+	return newCastError(ReasonOverflow, fromItem, toItem, nil, fmt.Errorf(errorOverRange, ErrCast, fromItem, toItem))
 }
 
 func newErrorUnderRange(fromItem any, toItem any) error {
-	return fmt.Errorf(errorUnderRange, ErrCast, fromItem, toItem)
+	return newCastError(ReasonUnderflow, fromItem, toItem, nil, fmt.Errorf(errorUnderRange, ErrCast, fromItem, toItem))
+}
+
+func newErrorFractional(fromItem any, toItem any) error {
+	return newCastError(ReasonFractional, fromItem, toItem, nil, fmt.Errorf(errorReason, ErrCast, ErrFractional, fromItem, toItem))
+}
+
+func newErrorNaN(fromItem any, toItem any) error {
+	return newCastError(ReasonNaN, fromItem, toItem, nil, fmt.Errorf(errorReason, ErrCast, ErrNaN, fromItem, toItem))
+}
+
+func newErrorInfinity(fromItem any, toItem any) error {
+	return newCastError(ReasonInfinity, fromItem, toItem, nil, fmt.Errorf(errorReason, ErrCast, ErrInfinity, fromItem, toItem))
+}
+
+func newErrorFloatUnderflow(fromItem any, toItem any) error {
+	return newCastError(ReasonFloatUnderflow, fromItem, toItem, nil, fmt.Errorf(errorReason, ErrCast, ErrFloatUnderflow, fromItem, toItem))
+}
+
+func newErrorPrecisionLoss(fromItem any, toItem any) error {
+	return newCastError(ReasonPrecisionLoss, fromItem, toItem, nil, fmt.Errorf(errorReason, ErrCast, ErrPrecisionLoss, fromItem, toItem))
 }
 
-func newErrorWithError(err error) error {
-	return fmt.Errorf(errorSimple, ErrCast, err.Error())
+// newErrorWithError returns an error which wraps the underlying error, such as *strconv.NumError, of parsing the source string.
+func newErrorWithError(err error, fromItem any, toItem any) error {
+	reason := ReasonSyntax
+	if errors.Is(err, strconv.ErrRange) {
+		reason = ReasonOverflow
+		if s, ok := fromItem.(string); ok && strings.HasPrefix(strings.TrimSpace(s), "-") {
+			reason = ReasonUnderflow
+		}
+	}
+	return newCastError(reason, fromItem, toItem, err, fmt.Errorf(errorSimple, ErrCast, err.Error()))
 }
 
 func newCompareError(item any, otherItem any) error {
-	return fmt.Errorf(errorCompare, ErrCast, item, item, otherItem, otherItem)
+	return newCastError(ReasonUnsupported, item, otherItem, nil, fmt.Errorf(errorCompare, ErrCast, item, item, otherItem, otherItem))
 }
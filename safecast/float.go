@@ -15,95 +15,81 @@
 package safecast
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 )
 
 // FromFloat64 casts an interface to an float64 type.
 func FromFloat64(from float64, to any) error {
+	switch to.(type) {
+	case *int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64:
+		if math.IsNaN(from) {
+			return newErrorNaN(from, to)
+		}
+		if math.IsInf(from, 0) {
+			return newErrorInfinity(from, to)
+		}
+	}
+
 	switch to := to.(type) {
 	case *int:
-		if float64(math.MaxInt) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < float64(math.MinInt) {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, -(1 << (strconv.IntSize - 1)), 1<<(strconv.IntSize-1), to); err != nil {
+			return err
 		}
 		*to = int(from)
 	case *int8:
-		if float64(math.MaxInt8) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < float64(math.MinInt8) {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, math.MinInt8, math.MaxInt8+1, to); err != nil {
+			return err
 		}
 		*to = int8(from)
 	case *int16:
-		if float64(math.MaxInt16) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < float64(math.MinInt16) {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, math.MinInt16, math.MaxInt16+1, to); err != nil {
+			return err
 		}
 		*to = int16(from)
 	case *int32:
-		if float64(math.MaxInt32) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < float64(math.MinInt32) {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, math.MinInt32, math.MaxInt32+1, to); err != nil {
+			return err
 		}
 		*to = int32(from)
 	case *int64:
-		if float64(math.MaxInt64) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < float64(math.MinInt64) {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, math.MinInt64, 1<<63, to); err != nil {
+			return err
 		}
 		*to = int64(from)
 	case *uint:
-		if float64(math.MaxUint) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < 0 {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, 0, 1<<strconv.IntSize, to); err != nil {
+			return err
 		}
 		*to = uint(from)
 	case *uint8:
-		if float64(math.MaxUint8) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < 0 {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, 0, math.MaxUint8+1, to); err != nil {
+			return err
 		}
 		*to = uint8(from)
 	case *uint16:
-		if float64(math.MaxUint16) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < 0 {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, 0, math.MaxUint16+1, to); err != nil {
+			return err
 		}
 		*to = uint16(from)
 	case *uint32:
-		if float64(math.MaxUint32) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < 0 {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, 0, math.MaxUint32+1, to); err != nil {
+			return err
 		}
 		*to = uint32(from)
 	case *uint64:
-		if float64(math.MaxUint64) < from {
-			return newErrorOverRange(from, to)
-		}
-		if from < 0 {
-			return newErrorUnderRange(from, to)
+		if err := checkFloat64Range(from, 0, 1<<64, to); err != nil {
+			return err
 		}
 		*to = uint64(from)
 	case *float32:
+		if err := checkFloat32Range(from, to); err != nil {
+			return err
+		}
 		*to = float32(from)
 	case *float64:
 		*to = from
@@ -115,6 +101,33 @@ func FromFloat64(from float64, to any) error {
 	return nil
 }
 
+// checkFloat64Range returns an error if the float value truncated toward zero is out of the range [lower, upper).
+// The bounds are exact powers of two, so the check is not affected by the rounding of math.MaxInt64 or math.MaxUint64 to float64.
+func checkFloat64Range(from float64, lower float64, upper float64, to any) error {
+	v := math.Trunc(from)
+	if upper <= v {
+		return newErrorOverRange(from, to)
+	}
+	if v < lower {
+		return newErrorUnderRange(from, to)
+	}
+	return nil
+}
+
+// checkFloat32Range returns an error if the finite float value overflows to an infinity as float32.
+func checkFloat32Range(from float64, to any) error {
+	if math.IsInf(from, 0) || math.IsNaN(from) {
+		return nil
+	}
+	switch f := float64(float32(from)); {
+	case math.IsInf(f, 1):
+		return newErrorOverRange(from, to)
+	case math.IsInf(f, -1):
+		return newErrorUnderRange(from, to)
+	}
+	return nil
+}
+
 // FromFloat32 casts an interface to an float32 type.
 func FromFloat32(from float32, to any) error {
 	return FromFloat64(float64(from), to)
@@ -125,7 +138,7 @@ func ToFloat64(from any, to *float64) error {
 	parseFloat := func(v string) (float64, error) {
 		f, err := strconv.ParseFloat(v, 64)
 		if err != nil {
-			return 0, newErrorCast(v, to)
+			return 0, newErrorWithError(err, v, to)
 		}
 		return f, nil
 	}
@@ -192,6 +205,16 @@ func ToFloat64(from any, to *float64) error {
 		if *to, err = parseFloat(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToFloat64(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -203,7 +226,7 @@ func ToFloat32(from any, to *float32) error {
 	parseFloat := func(v string) (float32, error) {
 		f, err := strconv.ParseFloat(v, 32)
 		if err != nil {
-			return 0, newErrorCast(from, to)
+			return 0, newErrorWithError(err, v, to)
 		}
 		return float32(f), nil
 	}
@@ -255,9 +278,9 @@ func ToFloat32(from any, to *float32) error {
 	case *float32:
 		*to = *from
 	case float64:
-		*to = float32(from)
+		return FromFloat64(from, to)
 	case *float64:
-		*to = float32(*from)
+		return FromFloat64(*from, to)
 	case string:
 		if *to, err = parseFloat(from); err != nil {
 			return err
@@ -270,6 +293,16 @@ func ToFloat32(from any, to *float32) error {
 		if *to, err = parseFloat(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToFloat32(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
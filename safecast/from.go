@@ -14,8 +14,79 @@
 
 package safecast
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"time"
+)
+
 // From casts an interface to an interface type.
+// Defined types such as `type UserID int64` are cast through the builtin type with the same underlying kind.
+// The text encodings of encoding.TextMarshaler and encoding.TextUnmarshaler take precedence over the underlying kinds.
+// The destinations implementing sql.Scanner and the sources implementing driver.Valuer such as sql.NullInt64 are supported.
+// The converters registered by RegisterConverter are consulted before the builtin conversions.
 func From(from any, to any) error {
+	return FromWith(from, to)
+}
+
+// FromWith casts an interface to an interface type like From with the options such as WithRoundingMode,
+// which change the default conversion behavior.
+func FromWith(from any, to any, opts ...Option) error {
+	if 0 < len(opts) {
+		return newConfig(opts...).from(from, to)
+	}
+	return defaultConfig.castFrom(from, to)
+}
+
+// castFrom casts an interface to an interface type like From, and casts the value field of a nullable struct,
+// the underlying type of a defined type and a json.Number with the configuration.
+func (cfg *config) castFrom(from any, to any) error {
+	from, ok, err := defaultConverters.cast(from, to)
+	if ok || err != nil {
+		return err
+	}
+	if ok, err := castText(from, to); ok {
+		return err
+	}
+	if ok, err := castToNullable(from, to, cfg.from); ok {
+		return err
+	}
+	if ok, err := castToScanner(from, to); ok {
+		return err
+	}
+	if n, ok := from.(json.Number); ok {
+		return fromJSONNumber(n, to, cfg.from)
+	}
+	if ok, err := castToBig(from, to); ok {
+		return err
+	}
+	if d, ok := to.(*time.Duration); ok {
+		return ToDuration(from, d)
+	}
+	if ok, err := castToUnderlyingBuiltin(from, to, cfg.from); ok {
+		return err
+	}
+	switch v := from.(type) {
+	case time.Duration:
+		return FromDuration(v, to)
+	case *time.Duration:
+		return FromDuration(*v, to)
+	}
+	if t, ok := timeValue(from); ok {
+		return FromTime(t, to)
+	}
+	if isStringerToString(from, to) {
+		if s, ok := to.(*string); ok {
+			return ToString(from, s)
+		}
+		if ok, err := castToUnderlyingBuiltin(from, to, cfg.from); ok {
+			return err
+		}
+	}
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
 	switch from := from.(type) {
 	case int:
 		return FromInt(from, to)
@@ -75,6 +146,14 @@ func From(from any, to any) error {
 		return FromBool(*from, to)
 	case []byte:
 		return FromBytes(from, to)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return From(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
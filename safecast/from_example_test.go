@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"time"
 )
 
 func ExampleFromInt() {
@@ -311,3 +312,39 @@ func ExampleFromBytes() {
 	// Output:
 	// abc
 }
+
+func ExampleFromTime() {
+	t := time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC)
+
+	var sec int64
+	if err := FromTime(t, &sec); err == nil {
+		fmt.Println(sec)
+	}
+
+	var msec int64
+	if err := FromTime(t, &msec, WithTimeUnit(time.Millisecond)); err == nil {
+		fmt.Println(msec)
+	}
+
+	var f float64
+	if err := FromTime(t, &f); err == nil {
+		fmt.Println(f)
+	}
+
+	var s string
+	if err := FromTime(t, &s, WithTimeLayouts(DateTime)); err == nil {
+		fmt.Println(s)
+	}
+
+	var nsec int32
+	if err := FromTime(t, &nsec, WithTimeUnit(time.Nanosecond)); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 1700000000
+	// 1700000000123
+	// 1.700000000123e+09
+	// 2023-11-14 22:13:20
+	// cast error : out of range 1700000000123000000 > *int32
+}
@@ -15,8 +15,11 @@
 package safecast
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -222,7 +225,7 @@ func ToInt8(from any, to *int8) error {
 		if err == nil {
 			return *to, ToInt8(fv, to)
 		}
-		return 0, newErrorCast(v, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -304,13 +307,13 @@ func ToInt8(from any, to *int8) error {
 			return err
 		}
 	case float32:
-		return ToInt8(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToInt8(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToInt8(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToInt8(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -327,6 +330,16 @@ func ToInt8(from any, to *int8) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToInt8(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -409,7 +422,7 @@ func ToInt16(from any, to *int16) error {
 		if err == nil {
 			return *to, ToInt16(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -483,13 +496,13 @@ func ToInt16(from any, to *int16) error {
 			return err
 		}
 	case float32:
-		return ToInt16(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToInt16(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToInt16(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToInt16(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -506,6 +519,16 @@ func ToInt16(from any, to *int16) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToInt16(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -571,7 +594,7 @@ func ToInt32(from any, to *int32) error {
 		if err == nil {
 			return *to, ToInt32(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -637,13 +660,13 @@ func ToInt32(from any, to *int32) error {
 			return err
 		}
 	case float32:
-		return ToInt32(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToInt32(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToInt32(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToInt32(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -660,6 +683,16 @@ func ToInt32(from any, to *int32) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToInt32(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -698,7 +731,7 @@ func ToInt64(from any, to *int64) error {
 		if err == nil {
 			return *to, ToInt64(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -752,13 +785,13 @@ func ToInt64(from any, to *int64) error {
 			return err
 		}
 	case float32:
-		*to = int64(from)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		*to = int64(*from)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		*to = int64(from)
+		return FromFloat64(from, to)
 	case *float64:
-		*to = int64(*from)
+		return FromFloat64(*from, to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -775,6 +808,16 @@ func ToInt64(from any, to *int64) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToInt64(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -811,9 +854,9 @@ func ToInt(from any, to *int) error {
 		}
 		fv, err := strconv.ParseFloat(v, 64)
 		if err == nil {
-			return int(fv), nil
+			return *to, FromFloat64(fv, to)
 		}
-		return 0, newErrorCast(v, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -867,13 +910,13 @@ func ToInt(from any, to *int) error {
 			return err
 		}
 	case float32:
-		*to = int(from)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		*to = int(*from)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		*to = int(from)
+		return FromFloat64(from, to)
 	case *float64:
-		*to = int(*from)
+		return FromFloat64(*from, to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -890,6 +933,16 @@ func ToInt(from any, to *int) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToInt(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -0,0 +1,311 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	errISO8601Syntax    = errors.New("invalid ISO 8601 format")
+	errISO8601Range     = errors.New("ISO 8601 value out of range")
+	errISO8601Underflow = fmt.Errorf("%w: less than the minimum", errISO8601Range)
+	errISO8601Overflow  = fmt.Errorf("%w: greater than the maximum", errISO8601Range)
+)
+
+// iso8601Format is the format of a component, which must be consistent within a representation.
+type iso8601Format int
+
+const (
+	iso8601Neutral iso8601Format = iota
+	iso8601Basic
+	iso8601Extended
+)
+
+// ParseISO8601 parses a date, or a date and time, in the ISO 8601 formats.
+// It accepts calendar dates (2024-01-02, 20240102), ordinal dates (2024-045, 2024045), week dates (2024-W12-3, 2024W123)
+// and dates with reduced precision (2024-05, 2024-W12, 2024) in the extended and basic formats.
+// A time follows a complete date after "T" with reduced precision (15, 15:04, 15:04:05) and a fraction of the last component
+// separated by "." or ",", such as 15:04:05,123, and 24:00 is the end of the day. The time zone is "Z" or an offset
+// such as +09, +09:00 or -0530. A date without a time, or a time without a time zone, is interpreted in the location,
+// or as UTC if the location is nil. The leap second 23:59:60 is rejected, since time.Time cannot represent it.
+// It returns a CastError wrapping ErrCast and ErrSyntax if the string is not valid, or ErrOverflow or ErrUnderflow
+// if a value such as the day of 2024-02-30 is out of range.
+func ParseISO8601(s string, loc *time.Location) (time.Time, error) {
+	t, err := parseISO8601(s, loc)
+	if err != nil {
+		err = fmt.Errorf("parsing time %q: %w", s, err)
+		reason := ReasonSyntax
+		switch {
+		case errors.Is(err, errISO8601Underflow):
+			reason = ReasonUnderflow
+		case errors.Is(err, errISO8601Overflow):
+			reason = ReasonOverflow
+		}
+		return time.Time{}, newCastError(reason, s, &t, err, fmt.Errorf(errorSimple, ErrCast, err.Error()))
+	}
+	return t, nil
+}
+
+func parseISO8601(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	date, clock, hasClock := strings.Cut(s, "T")
+	year, month, day, dateFormat, complete, err := parseISO8601Date(date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !hasClock {
+		return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+	}
+	if !complete {
+		return time.Time{}, errISO8601Syntax
+	}
+
+	zoneLoc := loc
+	offset := 0
+	hasZone := false
+	if i := strings.IndexAny(clock, "Z+-"); 0 <= i {
+		var zoneFormat iso8601Format
+		offset, zoneFormat, err = parseISO8601Zone(clock[i:])
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !compatibleISO8601Formats(dateFormat, zoneFormat) {
+			return time.Time{}, errISO8601Syntax
+		}
+		clock = clock[:i]
+		hasZone = true
+		zoneLoc = time.UTC
+		if offset != 0 {
+			zoneLoc = time.FixedZone("", offset)
+		}
+	}
+
+	clock, frac, hasFrac := strings.Cut(strings.Replace(clock, ",", ".", 1), ".")
+	if hasFrac && !isISO8601Digits(frac, len(frac)) {
+		return time.Time{}, errISO8601Syntax
+	}
+	var parts []string
+	clockFormat := iso8601Neutral
+	if strings.Contains(clock, ":") {
+		clockFormat = iso8601Extended
+		parts = strings.Split(clock, ":")
+	} else {
+		if 2 < len(clock) {
+			clockFormat = iso8601Basic
+		}
+		for i := 0; i+2 <= len(clock); i += 2 {
+			parts = append(parts, clock[i:i+2])
+		}
+		if len(clock)%2 != 0 {
+			return time.Time{}, errISO8601Syntax
+		}
+	}
+	if len(parts) < 1 || 3 < len(parts) || !compatibleISO8601Formats(dateFormat, clockFormat) {
+		return time.Time{}, errISO8601Syntax
+	}
+	values := []int{0, 0, 0}
+	for i, part := range parts {
+		if !isISO8601Digits(part, 2) {
+			return time.Time{}, errISO8601Syntax
+		}
+		values[i] = atoiISO8601(part)
+	}
+	hour, minute, second := values[0], values[1], values[2]
+	if 24 < hour || 59 < minute || 59 < second {
+		return time.Time{}, errISO8601Overflow
+	}
+	if hour == 24 && (minute != 0 || second != 0 || strings.Trim(frac, "0") != "") {
+		return time.Time{}, errISO8601Overflow
+	}
+
+	t := time.Date(year, month, day, hour, minute, second, 0, zoneLoc)
+	if hasFrac {
+		unit := []time.Duration{time.Hour, time.Minute, time.Second}[len(parts)-1]
+		n, _ := new(big.Int).SetString(frac, 10)
+		n.Mul(n, big.NewInt(int64(unit)))
+		n.Quo(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil))
+		t = t.Add(time.Duration(n.Int64()))
+	}
+	// Like time.ParseInLocation, an offset which matches the location is interpreted in the location.
+	if hasZone && zoneLoc != loc {
+		if _, o := t.In(loc).Zone(); o == offset {
+			t = t.In(loc)
+		}
+	}
+	return t, nil
+}
+
+// parseISO8601Date parses a calendar, ordinal or week date, and returns false as complete if the date has reduced precision.
+func parseISO8601Date(s string) (int, time.Month, int, iso8601Format, bool, error) {
+	if len(s) < 4 || !isISO8601Digits(s[:4], 4) {
+		return 0, 0, 0, iso8601Neutral, false, errISO8601Syntax
+	}
+	year := atoiISO8601(s[:4])
+	s = s[4:]
+	if s == "" {
+		return year, time.January, 1, iso8601Neutral, false, nil
+	}
+	format := iso8601Basic
+	if s[0] == '-' {
+		format = iso8601Extended
+		s = s[1:]
+	}
+
+	if strings.HasPrefix(s, "W") {
+		s = s[1:]
+		if format == iso8601Extended && 3 < len(s) && s[2] == '-' {
+			s = s[:2] + s[3:]
+		} else if format == iso8601Extended && len(s) == 3 {
+			return 0, 0, 0, format, false, errISO8601Syntax
+		}
+		if (len(s) != 2 && len(s) != 3) || !isISO8601Digits(s, len(s)) {
+			return 0, 0, 0, format, false, errISO8601Syntax
+		}
+		week, weekday := atoiISO8601(s[:2]), 1
+		if len(s) == 3 {
+			weekday = atoiISO8601(s[2:])
+		}
+		if err := checkISO8601Range(week, 1, isoWeeksIn(year)); err != nil {
+			return 0, 0, 0, format, false, err
+		}
+		if err := checkISO8601Range(weekday, 1, 7); err != nil {
+			return 0, 0, 0, format, false, err
+		}
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		date := monday.AddDate(0, 0, (week-1)*7+weekday-1)
+		return date.Year(), date.Month(), date.Day(), format, len(s) == 3, nil
+	}
+
+	switch {
+	case len(s) == 3 && isISO8601Digits(s, 3):
+		yday := atoiISO8601(s)
+		if err := checkISO8601Range(yday, 1, time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()); err != nil {
+			return 0, 0, 0, format, false, err
+		}
+		date := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+		return year, date.Month(), date.Day(), format, true, nil
+	case format == iso8601Extended && len(s) == 2 && isISO8601Digits(s, 2):
+		month := atoiISO8601(s)
+		if err := checkISO8601Range(month, 1, 12); err != nil {
+			return 0, 0, 0, format, false, err
+		}
+		return year, time.Month(month), 1, format, false, nil
+	case format == iso8601Extended && len(s) == 5 && s[2] == '-':
+		s = s[:2] + s[3:]
+	case format == iso8601Extended || len(s) != 4:
+		return 0, 0, 0, format, false, errISO8601Syntax
+	}
+	if !isISO8601Digits(s, 4) {
+		return 0, 0, 0, format, false, errISO8601Syntax
+	}
+	month, day := atoiISO8601(s[:2]), atoiISO8601(s[2:])
+	if err := checkISO8601Range(month, 1, 12); err != nil {
+		return 0, 0, 0, format, false, err
+	}
+	if err := checkISO8601Range(day, 1, time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()); err != nil {
+		return 0, 0, 0, format, false, err
+	}
+	return year, time.Month(month), day, format, true, nil
+}
+
+// parseISO8601Zone parses "Z" or an offset such as +09, +09:00 or -0530, and returns the offset in seconds.
+func parseISO8601Zone(s string) (int, iso8601Format, error) {
+	if s == "Z" {
+		return 0, iso8601Neutral, nil
+	}
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, iso8601Neutral, errISO8601Syntax
+	}
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	s = s[1:]
+	format := iso8601Neutral
+	switch len(s) {
+	case 2:
+	case 4:
+		format = iso8601Basic
+	case 5:
+		if s[2] != ':' {
+			return 0, format, errISO8601Syntax
+		}
+		format = iso8601Extended
+		s = s[:2] + s[3:]
+	default:
+		return 0, format, errISO8601Syntax
+	}
+	if !isISO8601Digits(s, len(s)) {
+		return 0, format, errISO8601Syntax
+	}
+	hour, minute := atoiISO8601(s[:2]), 0
+	if 2 < len(s) {
+		minute = atoiISO8601(s[2:])
+	}
+	if 23 < hour || 59 < minute {
+		return 0, format, errISO8601Overflow
+	}
+	return sign * (hour*60*60 + minute*60), format, nil
+}
+
+// checkISO8601Range returns an error if the value is not between the minimum and maximum values.
+func checkISO8601Range(v int, minValue int, maxValue int) error {
+	switch {
+	case v < minValue:
+		return errISO8601Underflow
+	case maxValue < v:
+		return errISO8601Overflow
+	}
+	return nil
+}
+
+// compatibleISO8601Formats returns true unless one component is in the basic format and the other in the extended format.
+func compatibleISO8601Formats(f1 iso8601Format, f2 iso8601Format) bool {
+	return f1 == iso8601Neutral || f2 == iso8601Neutral || f1 == f2
+}
+
+// isoWeeksIn returns the number of ISO weeks, 52 or 53, in the year.
+func isoWeeksIn(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+func isISO8601Digits(s string, n int) bool {
+	if len(s) != n || n == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func atoiISO8601(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// fromJSONNumber casts a json.Number as a string, so an integer number is parsed exactly without going through float64.
+// A number with a fraction or an exponent such as 1e3 is cast to an integer type through float64 with the range checks.
+func fromJSONNumber(from json.Number, to any, cast func(from any, to any) error) error {
+	err := cast(string(from), to)
+	if err == nil || !errors.Is(err, ErrSyntax) || !isIntegerPointer(to) {
+		return err
+	}
+	f, ferr := from.Float64()
+	if ferr != nil {
+		return err
+	}
+	return cast(f, to)
+}
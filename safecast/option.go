@@ -0,0 +1,266 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Option configures the behavior of a conversion.
+type Option func(*config)
+
+type config struct {
+	roundingMode        RoundingMode
+	precisionCheck      bool
+	ieeeOverflow        bool
+	floatUnderflowError bool
+	strictBoolParsing   bool
+	timeLayouts         []string
+	location            *time.Location
+	timeUnit            time.Duration
+	zoneAbbrs           map[string]*time.Location
+	nilAsZero           bool
+	trimSpace           bool
+	converters          *converterRegistry
+	// hasOptions is false if the configuration has no options, so the direct To* functions can be used.
+	hasOptions bool
+}
+
+// defaultConfig is the configuration of the package-level functions without options.
+var defaultConfig = newConfig()
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{
+		roundingMode: RoundTruncate,
+		hasOptions:   0 < len(opts),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithRoundingMode sets the rounding mode used when a float value is cast to an integer type.
+func WithRoundingMode(mode RoundingMode) Option {
+	return func(cfg *config) {
+		cfg.roundingMode = mode
+	}
+}
+
+// WithPrecisionCheck enables the precision check when a value is cast to a float type.
+// The conversion returns ErrPrecisionLoss if the value cannot be represented by the float type exactly,
+// such as an integer larger than 2^53 to float64, or a float64 value narrowed to float32.
+func WithPrecisionCheck() Option {
+	return func(cfg *config) {
+		cfg.precisionCheck = true
+	}
+}
+
+// WithIEEEOverflow disables the float32 range check, so a float64 value which overflows float32
+// is cast to a signed infinity as IEEE 754 specifies instead of returning an error.
+func WithIEEEOverflow() Option {
+	return func(cfg *config) {
+		cfg.ieeeOverflow = true
+	}
+}
+
+// WithFloatUnderflowError enables the underflow check when a float64 value or a numeric string is cast to float32.
+// The conversion returns ErrFloatUnderflow if a non-zero value is rounded to zero as float32.
+func WithFloatUnderflowError() Option {
+	return func(cfg *config) {
+		cfg.floatUnderflowError = true
+	}
+}
+
+// WithStrictBoolParsing accepts only "true" and "false" when a string is cast to a bool type.
+// By default, all the values accepted by strconv.ParseBool such as "1", "t" and "TRUE" are accepted.
+func WithStrictBoolParsing() Option {
+	return func(cfg *config) {
+		cfg.strictBoolParsing = true
+	}
+}
+
+// WithTimeLayouts sets the layouts used when a string is cast to a time.Time.
+// By default, SupportedTimeLayouts are used.
+func WithTimeLayouts(layouts ...string) Option {
+	return func(cfg *config) {
+		cfg.timeLayouts = layouts
+	}
+}
+
+// WithLocation sets the location used when a string without a time zone is cast to a time.Time.
+// By default, such a string is interpreted as UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(cfg *config) {
+		cfg.location = loc
+	}
+}
+
+// WithTimeUnit sets the unit of a Unix time when a number is cast to a time.Time, or a time.Time is cast to a number.
+// By default, the unit of a number is detected from its magnitude, and a time.Time is cast to a number in seconds.
+// A numeric string is cast to a time.Time as a Unix time only if the unit is set.
+func WithTimeUnit(unit time.Duration) Option {
+	return func(cfg *config) {
+		cfg.timeUnit = unit
+	}
+}
+
+// WithNilAsZero casts a nil value, a nil pointer, or a NULL value such as an invalid sql.NullInt64, to the zero value of the destination type instead of returning an error.
+func WithNilAsZero() Option {
+	return func(cfg *config) {
+		cfg.nilAsZero = true
+	}
+}
+
+// WithTrimSpace trims leading and trailing white spaces of a string before it is cast.
+func WithTrimSpace() Option {
+	return func(cfg *config) {
+		cfg.trimSpace = true
+	}
+}
+
+// isNil returns true if the value is nil or a nil pointer.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// setZero sets the zero value to the destination pointer.
+func setZero(to any) error {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return newErrorCast(nil, to)
+	}
+	tv.Elem().SetZero()
+	return nil
+}
+
+// normalizeString applies the string options to a string value. Other values are returned as they are.
+func (cfg *config) normalizeString(from any) any {
+	if !cfg.trimSpace {
+		return from
+	}
+	switch v := from.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case *string:
+		if v != nil {
+			return strings.TrimSpace(*v)
+		}
+	case []byte:
+		return strings.TrimSpace(string(v))
+	default:
+		if bv, ok := toUnderlyingBuiltin(from); ok {
+			if s, ok := bv.(string); ok {
+				return strings.TrimSpace(s)
+			}
+		}
+	}
+	return from
+}
+
+// parseStrictBool parses a string strictly when it is cast to a bool type. A nil string pointer returns ErrNil.
+func (cfg *config) parseStrictBool(from any, to any) (any, error) {
+	if !cfg.strictBoolParsing {
+		return from, nil
+	}
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.Type().Elem().Kind() != reflect.Bool {
+		return from, nil
+	}
+	var s string
+	switch v := from.(type) {
+	case string:
+		s = v
+	case *string:
+		if v == nil {
+			return nil, newErrorNil(from, to)
+		}
+		s = *v
+	case []byte:
+		s = string(v)
+	default:
+		return from, nil
+	}
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return nil, newErrorSyntax(s, to)
+}
+
+// prepare applies the configuration to the value before it is cast to the destination.
+func (cfg *config) prepare(from any, to any) (any, error) {
+	from, err := cfg.parseStrictBool(cfg.normalizeString(from), to)
+	if err != nil {
+		return nil, err
+	}
+	from, err = cfg.roundFloat(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.precisionCheck {
+		if err := checkPrecision(from, to); err != nil {
+			return nil, err
+		}
+	}
+	return cfg.narrowFloat32(from, to)
+}
+
+// cast casts an interface to an interface type with the configuration and the cast function.
+func (cfg *config) cast(from any, to any, cast func(from any, to any) error) error {
+	if !cfg.hasOptions {
+		return cast(from, to)
+	}
+	if cfg.nilAsZero && isNull(from) {
+		return setZero(to)
+	}
+	from, ok, err := cfg.converters.cast(from, to)
+	if ok || err != nil {
+		return err
+	}
+	from, err = cfg.prepare(from, to)
+	if err != nil {
+		return err
+	}
+	if t, ok := to.(*time.Time); ok && (0 < len(cfg.timeLayouts) || cfg.location != nil || cfg.timeUnit != 0 || cfg.zoneAbbrs != nil) {
+		return cfg.toTime(from, t)
+	}
+	if t, ok := timeValue(from); ok {
+		return cfg.fromTime(t, to)
+	}
+	return cast(from, to)
+}
+
+// to casts an interface to an interface type with the configuration.
+func (cfg *config) to(from any, to any) error {
+	return cfg.cast(from, to, cfg.castTo)
+}
+
+// from casts an interface to an interface type with the configuration.
+func (cfg *config) from(from any, to any) error {
+	return cfg.cast(from, to, cfg.castFrom)
+}
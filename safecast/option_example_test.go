@@ -0,0 +1,61 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+)
+
+func ExampleWithRoundingMode() {
+	var to int64
+
+	if err := To(3.7, &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToWith(3.7, &to, WithRoundingMode(RoundHalfAwayFromZero)); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToWith("2.5", &to, WithRoundingMode(RoundHalfEven)); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToWith(3.7, &to, WithRoundingMode(RoundExact)); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 3
+	// 4
+	// 2
+	// cast error : fractional 3.7 => *int64
+}
+
+func ExampleWithPrecisionCheck() {
+	var to float64
+
+	if err := To(int64(1<<53+1), &to); err == nil {
+		fmt.Println(int64(to))
+	}
+
+	if err := ToWith(int64(1<<53+1), &to, WithPrecisionCheck()); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 9007199254740992
+	// cast error : precision loss 9007199254740993 => *float64
+}
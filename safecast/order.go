@@ -0,0 +1,163 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+	"slices"
+)
+
+// The ordering helpers share one total order, so Less, Min, Max and SortAny always agree.
+// Values of different type groups are ordered as nil < bool < numbers < strings < bytes < time,
+// and values of the same group are ordered by Compare, so numbers of different types are compared exactly.
+
+// Less returns true if v1 is less than v2 in the total order of the ordering helpers.
+func Less(v1 any, v2 any) (bool, error) {
+	r, err := compareTotal(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return r < 0, nil
+}
+
+// LessOrEqual returns true if v1 is less than or equal to v2 in the total order of the ordering helpers.
+func LessOrEqual(v1 any, v2 any) (bool, error) {
+	r, err := compareTotal(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return r <= 0, nil
+}
+
+// Greater returns true if v1 is greater than v2 in the total order of the ordering helpers.
+func Greater(v1 any, v2 any) (bool, error) {
+	r, err := compareTotal(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return 0 < r, nil
+}
+
+// GreaterOrEqual returns true if v1 is greater than or equal to v2 in the total order of the ordering helpers.
+func GreaterOrEqual(v1 any, v2 any) (bool, error) {
+	r, err := compareTotal(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return 0 <= r, nil
+}
+
+// Between returns true if v is in the closed range [lo, hi] in the total order of the ordering helpers.
+func Between(v any, lo any, hi any) (bool, error) {
+	ok, err := GreaterOrEqual(v, lo)
+	if err != nil || !ok {
+		return false, err
+	}
+	return LessOrEqual(v, hi)
+}
+
+// Min returns the minimum value of the values in the total order of the ordering helpers.
+// It returns ErrNil if no values are given.
+func Min(values ...any) (any, error) {
+	return extremum(values, -1)
+}
+
+// Max returns the maximum value of the values in the total order of the ordering helpers.
+// It returns ErrNil if no values are given.
+func Max(values ...any) (any, error) {
+	return extremum(values, 1)
+}
+
+// SortAny sorts the values in ascending order in the total order of the ordering helpers. The sort is stable.
+// It returns an error if two values cannot be compared, and then the values are left unchanged.
+func SortAny(values []any) error {
+	sorted := slices.Clone(values)
+	var err error
+	slices.SortStableFunc(sorted, func(v1, v2 any) int {
+		if err != nil {
+			return 0
+		}
+		var r int
+		r, err = compareTotal(v1, v2)
+		return r
+	})
+	if err != nil {
+		return err
+	}
+	copy(values, sorted)
+	return nil
+}
+
+func extremum(values []any, sign int) (any, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w : %w : no values", ErrCast, ErrNil)
+	}
+	m := values[0]
+	for _, v := range values[1:] {
+		r, err := compareTotal(v, m)
+		if err != nil {
+			return nil, err
+		}
+		if r*sign > 0 {
+			m = v
+		}
+	}
+	return m, nil
+}
+
+const (
+	orderNil = iota
+	orderBool
+	orderNumber
+	orderString
+	orderBytes
+	orderTime
+	orderOther
+)
+
+// orderRank returns the rank of the type group of the value in the total order.
+func orderRank(v any) int {
+	if v == nil || isNilPointer(v) {
+		return orderNil
+	}
+	switch comparePriority(v) {
+	case priorityBool:
+		return orderBool
+	case priorityNumber:
+		return orderNumber
+	case priorityString:
+		return orderString
+	case priorityBytes:
+		return orderBytes
+	case priorityTime:
+		return orderTime
+	}
+	return orderOther
+}
+
+// compareTotal compares two values in the total order across the type groups.
+func compareTotal(v1 any, v2 any) (int, error) {
+	r1, r2 := orderRank(v1), orderRank(v2)
+	if r1 != r2 {
+		if r1 < r2 {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	if r1 == orderNil {
+		return 0, nil
+	}
+	return Compare(v1, v2)
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+)
+
+func ExampleBetween() {
+	if ok, err := Between(int8(5), uint64(1), 10.5); err == nil {
+		fmt.Println(ok)
+	}
+
+	// Output:
+	// true
+}
+
+func ExampleSortAny() {
+	values := []any{"b", 3, true, nil, int8(-1), "a", 2.5}
+	if err := SortAny(values); err == nil {
+		fmt.Println(values...)
+	}
+
+	// Output:
+	// <nil> true -1 2.5 3 a b
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// floatPointerBitSize returns the bit size of the destination if it is a pointer to a float type, otherwise 0.
+func floatPointerBitSize(to any) int {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer {
+		return 0
+	}
+	switch tv.Type().Elem().Kind() {
+	case reflect.Float32:
+		return 32
+	case reflect.Float64:
+		return 64
+	}
+	return 0
+}
+
+// isExactInt64Float returns true if the int64 value is represented by the float type of the bit size exactly.
+func isExactInt64Float(v int64, bitSize int) bool {
+	f := float64(v)
+	if bitSize == 32 {
+		f = float64(float32(v))
+	}
+	return f < 1<<63 && int64(f) == v
+}
+
+// isExactUint64Float returns true if the uint64 value is represented by the float type of the bit size exactly.
+func isExactUint64Float(v uint64, bitSize int) bool {
+	f := float64(v)
+	if bitSize == 32 {
+		f = float64(float32(v))
+	}
+	return f < 1<<64 && uint64(f) == v
+}
+
+// isExactFloat64Float returns true if the float64 value is represented by the float type of the bit size exactly.
+func isExactFloat64Float(v float64, bitSize int) bool {
+	if bitSize == 64 || math.IsNaN(v) {
+		return true
+	}
+	return float64(float32(v)) == v
+}
+
+// checkPrecision returns ErrPrecisionLoss if the value cannot be represented by the float destination exactly.
+// Strings are checked only if they represent integers, because decimal fractions are rarely exact in binary.
+func checkPrecision(from any, to any) error {
+	bitSize := floatPointerBitSize(to)
+	if bitSize == 0 {
+		return nil
+	}
+
+	checkString := func(v string) bool {
+		if iv, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return isExactInt64Float(iv, bitSize)
+		}
+		if uv, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return isExactUint64Float(uv, bitSize)
+		}
+		return true
+	}
+
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
+
+	var exact bool
+	switch v := from.(type) {
+	case int:
+		exact = isExactInt64Float(int64(v), bitSize)
+	case *int:
+		exact = isExactInt64Float(int64(*v), bitSize)
+	case int8, *int8, int16, *int16, uint8, *uint8, uint16, *uint16, float32, *float32, bool, *bool:
+		exact = true
+	case int32:
+		exact = isExactInt64Float(int64(v), bitSize)
+	case *int32:
+		exact = isExactInt64Float(int64(*v), bitSize)
+	case int64:
+		exact = isExactInt64Float(v, bitSize)
+	case *int64:
+		exact = isExactInt64Float(*v, bitSize)
+	case uint:
+		exact = isExactUint64Float(uint64(v), bitSize)
+	case *uint:
+		exact = isExactUint64Float(uint64(*v), bitSize)
+	case uint32:
+		exact = isExactUint64Float(uint64(v), bitSize)
+	case *uint32:
+		exact = isExactUint64Float(uint64(*v), bitSize)
+	case uint64:
+		exact = isExactUint64Float(v, bitSize)
+	case *uint64:
+		exact = isExactUint64Float(*v, bitSize)
+	case float64:
+		exact = isExactFloat64Float(v, bitSize)
+	case *float64:
+		exact = isExactFloat64Float(*v, bitSize)
+	case string:
+		exact = checkString(v)
+	case *string:
+		exact = checkString(*v)
+	case []byte:
+		exact = checkString(string(v))
+	default:
+		exact = true
+	}
+	if !exact {
+		return newErrorPrecisionLoss(from, to)
+	}
+	return nil
+}
+
+// narrowFloat32 applies the float32 overflow and underflow options to a float64 value cast to float32.
+func (cfg *config) narrowFloat32(from any, to any) (any, error) {
+	if !cfg.ieeeOverflow && !cfg.floatUnderflowError {
+		return from, nil
+	}
+	if floatPointerBitSize(to) != 32 {
+		return from, nil
+	}
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
+	var v float64
+	var nonzero bool
+	switch from := from.(type) {
+	case float64:
+		v, nonzero = from, from != 0
+	case *float64:
+		v, nonzero = *from, *from != 0
+	case string:
+		v, nonzero = parseFloat32Source(from)
+	case *string:
+		v, nonzero = parseFloat32Source(*from)
+	case []byte:
+		v, nonzero = parseFloat32Source(string(from))
+	default:
+		return from, nil
+	}
+	f := float32(v)
+	if cfg.ieeeOverflow && math.IsInf(float64(f), 0) {
+		return float64(f), nil
+	}
+	if cfg.floatUnderflowError && f == 0 && nonzero {
+		return nil, newErrorFloatUnderflow(from, to)
+	}
+	return from, nil
+}
+
+// parseFloat32Source parses a string cast to float32, and returns false if the value is zero or the string is not a number.
+// A value which underflows float64 is still reported as non-zero.
+func parseFloat32Source(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, false
+	}
+	if v != 0 {
+		return v, true
+	}
+	f, _, err := big.ParseFloat(s, 0, 64, big.ToNearestEven)
+	return 0, err == nil && f.Sign() != 0
+}
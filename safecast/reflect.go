@@ -0,0 +1,111 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var builtinKindTypes = map[reflect.Kind]reflect.Type{
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Bool:    reflect.TypeOf(false),
+}
+
+var builtinBytesType = reflect.TypeOf([]byte(nil))
+
+// underlyingBuiltinType returns the builtin type which has the same underlying kind as the specified defined type.
+// It returns false if the type is already a builtin type or has no corresponding builtin type.
+func underlyingBuiltinType(t reflect.Type) (reflect.Type, bool) {
+	bt, ok := builtinKindTypes[t.Kind()]
+	if !ok {
+		if t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Uint8 {
+			return nil, false
+		}
+		bt = builtinBytesType
+	}
+	if t == bt {
+		return nil, false
+	}
+	return bt, true
+}
+
+// isStringerToString returns true if a source implementing fmt.Stringer is cast to a string or a defined string type,
+// which is formatted by its String method like ToString instead of its underlying kind.
+func isStringerToString(from any, to any) bool {
+	if _, ok := from.(fmt.Stringer); !ok || isNil(from) {
+		return false
+	}
+	tv := reflect.ValueOf(to)
+	return tv.IsValid() && tv.Kind() == reflect.Pointer && !tv.IsNil() && tv.Elem().Kind() == reflect.String
+}
+
+// toUnderlyingBuiltin converts a value of a defined type, or a pointer to it, into the value of the builtin type.
+// It returns false if the value is not a defined type.
+func toUnderlyingBuiltin(from any) (any, bool) {
+	fv := reflect.ValueOf(from)
+	if !fv.IsValid() {
+		return nil, false
+	}
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return nil, false
+		}
+		fv = fv.Elem()
+	}
+	bt, ok := underlyingBuiltinType(fv.Type())
+	if !ok {
+		return nil, false
+	}
+	return fv.Convert(bt).Interface(), true
+}
+
+// castToUnderlyingBuiltin casts a value into the destination pointer of a defined type
+// by casting it to the builtin type with the same underlying kind.
+// A defined type over int64, such as a defined type over time.Duration, also accepts a Go duration string such as "1h30m"
+// which is not an integer, since reflection cannot tell the defined types over time.Duration from the others.
+// It returns false if the destination is not a pointer to a defined type.
+func castToUnderlyingBuiltin(from any, to any, cast func(from any, to any) error) (bool, error) {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return false, nil
+	}
+	bt, ok := underlyingBuiltinType(tv.Elem().Type())
+	if !ok {
+		return false, nil
+	}
+	bv := reflect.New(bt)
+	if err := cast(from, bv.Interface()); err != nil {
+		d, ok := durationString(from)
+		if !ok || bt.Kind() != reflect.Int64 {
+			return true, err
+		}
+		bv.Elem().SetInt(int64(d))
+	}
+	tv.Elem().Set(bv.Elem().Convert(tv.Elem().Type()))
+	return true, nil
+}
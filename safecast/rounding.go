@@ -0,0 +1,225 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode represents how a float value is rounded when it is cast to an integer type.
+type RoundingMode int
+
+const (
+	// RoundTruncate rounds toward zero. This is the default rounding mode.
+	RoundTruncate RoundingMode = iota
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+	// RoundHalfEven rounds to the nearest integer, and rounds half to even.
+	RoundHalfEven
+	// RoundHalfAwayFromZero rounds to the nearest integer, and rounds half away from zero.
+	RoundHalfAwayFromZero
+	// RoundExact rejects a value which has a non-zero fractional part with ErrFractional.
+	RoundExact
+)
+
+// String returns the string representation of the rounding mode.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundTruncate:
+		return "truncate"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfAwayFromZero:
+		return "half-away-from-zero"
+	case RoundExact:
+		return "exact"
+	default:
+		return "unknown"
+	}
+}
+
+// round rounds a float value to an integral value with the rounding mode.
+// It returns false if the mode is RoundExact and the value has a non-zero fractional part.
+func (mode RoundingMode) round(v float64) (float64, bool) {
+	switch mode {
+	case RoundFloor:
+		return math.Floor(v), true
+	case RoundCeil:
+		return math.Ceil(v), true
+	case RoundHalfEven:
+		return math.RoundToEven(v), true
+	case RoundHalfAwayFromZero:
+		return math.Round(v), true
+	case RoundExact:
+		if math.IsNaN(v) || math.IsInf(v, 0) || v == math.Trunc(v) {
+			return v, true
+		}
+		return 0, false
+	default:
+		return math.Trunc(v), true
+	}
+}
+
+// isIntegerPointer returns true if the destination is a pointer to an integer type.
+func isIntegerPointer(to any) bool {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer {
+		return false
+	}
+	switch tv.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// roundFloat rounds a float value, or a string value representing a float, with the rounding mode
+// when the value is cast to an integer type. Other values are returned as they are.
+func (cfg *config) roundFloat(from any, to any) (any, error) {
+	if !isIntegerPointer(to) {
+		return from, nil
+	}
+
+	roundString := func(v string) (any, error) {
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return v, nil
+		}
+		if _, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return v, nil
+		}
+		if r, ok := parseDecimalRat(v); ok {
+			return cfg.roundRat(r, v, to)
+		}
+		fv, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return v, nil
+		}
+		return cfg.roundFloat64(fv, to)
+	}
+
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
+	switch from := from.(type) {
+	case float32:
+		return cfg.roundFloat64(float64(from), to)
+	case *float32:
+		return cfg.roundFloat64(float64(*from), to)
+	case float64:
+		return cfg.roundFloat64(from, to)
+	case *float64:
+		return cfg.roundFloat64(*from, to)
+	case string:
+		return roundString(from)
+	case *string:
+		return roundString(*from)
+	case []byte:
+		return roundString(string(from))
+	}
+	return from, nil
+}
+
+func (cfg *config) roundFloat64(from float64, to any) (any, error) {
+	v, ok := cfg.roundingMode.round(from)
+	if !ok {
+		return nil, newErrorFractional(from, to)
+	}
+	return v, nil
+}
+
+// roundRat rounds a rational value exactly with the rounding mode, and returns an int64 or a *big.Int
+// which is checked against the range of the destination type by the cast.
+func (cfg *config) roundRat(r *big.Rat, from any, to any) (any, error) {
+	if !r.IsInt() {
+		num, denom := r.Num(), r.Denom()
+		floor, mod := new(big.Int).DivMod(num, denom, new(big.Int))
+		ceil := new(big.Int).Add(floor, big.NewInt(1))
+		// half compares the fractional part with one half: -1 below, 0 at and 1 above.
+		half := new(big.Int).Lsh(mod, 1).Cmp(denom)
+		var v *big.Int
+		switch cfg.roundingMode {
+		case RoundFloor:
+			v = floor
+		case RoundCeil:
+			v = ceil
+		case RoundHalfEven:
+			v = floor
+			if 0 < half || (half == 0 && floor.Bit(0) == 1) {
+				v = ceil
+			}
+		case RoundHalfAwayFromZero:
+			v = floor
+			if 0 < half || (half == 0 && 0 < r.Sign()) {
+				v = ceil
+			}
+		case RoundExact:
+			return nil, newErrorFractional(from, to)
+		default:
+			v = floor
+			if r.Sign() < 0 {
+				v = ceil
+			}
+		}
+		r = new(big.Rat).SetInt(v)
+	}
+	if r.Num().IsInt64() {
+		return r.Num().Int64(), nil
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// parseDecimalRat parses a decimal string such as "-12.5e3" into an exact rational value.
+// It returns false for the other strings such as hexadecimal floats, NaN and infinity, and for a huge exponent.
+// An exponent small enough for the value to be less than 0.1 is replaced, since only its sign matters for rounding.
+func parseDecimalRat(s string) (*big.Rat, bool) {
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); 0 <= i {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, false
+		}
+		mantissa, exp = s[:i], e
+	}
+	digits := strings.TrimLeft(mantissa, "+-")
+	if len(mantissa)-len(digits) > 1 || digits == "" || digits == "." || strings.Count(digits, ".") > 1 ||
+		strings.Trim(digits, "0123456789.") != "" {
+		return nil, false
+	}
+	if strings.Trim(digits, "0.") == "" {
+		return new(big.Rat), true
+	}
+	switch {
+	case len(digits)+400 < exp:
+		return nil, false
+	case exp < -(len(digits) + 1):
+		r := big.NewRat(1, 10)
+		if strings.HasPrefix(mantissa, "-") {
+			r.Neg(r)
+		}
+		return r, true
+	}
+	return new(big.Rat).SetString(s)
+}
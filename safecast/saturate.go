@@ -0,0 +1,111 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"errors"
+	"math"
+	"reflect"
+)
+
+// rangeBound returns the maximum or minimum value of the destination type.
+// It returns false if the destination is not a pointer to a numeric type.
+func rangeBound(to any, upper bool) (any, bool) {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer {
+		return nil, false
+	}
+	var maxValue, minValue any
+	switch tv.Type().Elem().Kind() {
+	case reflect.Int:
+		maxValue, minValue = int(math.MaxInt), int(math.MinInt)
+	case reflect.Int8:
+		maxValue, minValue = int8(math.MaxInt8), int8(math.MinInt8)
+	case reflect.Int16:
+		maxValue, minValue = int16(math.MaxInt16), int16(math.MinInt16)
+	case reflect.Int32:
+		maxValue, minValue = int32(math.MaxInt32), int32(math.MinInt32)
+	case reflect.Int64:
+		maxValue, minValue = int64(math.MaxInt64), int64(math.MinInt64)
+	case reflect.Uint:
+		maxValue, minValue = uint(math.MaxUint), uint(0)
+	case reflect.Uint8:
+		maxValue, minValue = uint8(math.MaxUint8), uint8(0)
+	case reflect.Uint16:
+		maxValue, minValue = uint16(math.MaxUint16), uint16(0)
+	case reflect.Uint32:
+		maxValue, minValue = uint32(math.MaxUint32), uint32(0)
+	case reflect.Uint64:
+		maxValue, minValue = uint64(math.MaxUint64), uint64(0)
+	case reflect.Float32:
+		maxValue, minValue = float32(math.MaxFloat32), float32(-math.MaxFloat32)
+	case reflect.Float64:
+		maxValue, minValue = float64(math.MaxFloat64), float64(-math.MaxFloat64)
+	default:
+		return nil, false
+	}
+	if upper {
+		return maxValue, true
+	}
+	return minValue, true
+}
+
+// Saturate casts an interface to an interface type like To, but clamps an out-of-range value
+// to the maximum or minimum value of the destination type instead of returning an error.
+// It returns true if the value is clamped. NaN, unparsable and unsupported values still return an error.
+// Saturate covers every source and destination pair of the To* and From* functions in a single function instead of
+// a saturating variant for each of them, since it accepts the same sources and destinations as To, including defined types,
+// and clamps to the bound selected by the Reason of the CastError, so the range checks stay in one place.
+func Saturate(from any, to any, opts ...Option) (bool, error) {
+	err := ToWith(from, to, opts...)
+	if err == nil {
+		return false, nil
+	}
+
+	var castErr *CastError
+	if !errors.As(err, &castErr) {
+		return false, err
+	}
+
+	var upper bool
+	switch castErr.Reason {
+	case ReasonOverflow:
+		upper = true
+	case ReasonUnderflow:
+		upper = false
+	case ReasonFloatUnderflow:
+		return true, To(0, to)
+	case ReasonInfinity:
+		switch v := castErr.From.(type) {
+		case float64:
+			upper = 0 < v
+		case float32:
+			upper = 0 < v
+		default:
+			return false, err
+		}
+	default:
+		return false, err
+	}
+
+	bound, ok := rangeBound(to, upper)
+	if !ok {
+		return false, err
+	}
+	if err := To(bound, to); err != nil {
+		return false, err
+	}
+	return true, nil
+}
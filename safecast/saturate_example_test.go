@@ -0,0 +1,37 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+)
+
+func ExampleSaturate() {
+	var to int8
+
+	clamped, _ := Saturate(300, &to)
+	fmt.Println(to, clamped)
+
+	clamped, _ = Saturate(-300, &to)
+	fmt.Println(to, clamped)
+
+	clamped, _ = Saturate(100, &to)
+	fmt.Println(to, clamped)
+
+	// Output:
+	// 127 true
+	// -128 true
+	// 100 false
+}
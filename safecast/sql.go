@@ -0,0 +1,116 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// driverValue returns the value of a driver.Valuer. A NULL value is returned as ErrNil.
+func driverValue(from driver.Valuer, to any) (any, error) {
+	if isNil(from) {
+		return nil, newErrorNil(from, to)
+	}
+	v, err := from.Value()
+	if err != nil {
+		return nil, newErrorWithError(err, from, to)
+	}
+	if v == nil {
+		return nil, newErrorNil(from, to)
+	}
+	return v, nil
+}
+
+// isNull returns true if the value is nil, a nil pointer, or a driver.Valuer whose value is NULL such as an invalid sql.NullInt64.
+func isNull(v any) bool {
+	if isNil(v) {
+		return true
+	}
+	valuer, ok := v.(driver.Valuer)
+	if !ok {
+		return false
+	}
+	dv, err := valuer.Value()
+	return err == nil && dv == nil
+}
+
+// nullableValue returns the value field and the Valid field of a nullable struct such as sql.NullInt64 and sql.Null[T].
+// A nullable struct implements sql.Scanner and has two fields which the second is the Valid bool field.
+func nullableValue(v reflect.Value) (reflect.Value, reflect.Value, bool) {
+	t := v.Type()
+	if t.Kind() != reflect.Struct || t.NumField() != 2 || !reflect.PointerTo(t).Implements(scannerType) {
+		return reflect.Value{}, reflect.Value{}, false
+	}
+	vf, ok := t.Field(0), t.Field(1)
+	if !vf.IsExported() || ok.Name != "Valid" || ok.Type.Kind() != reflect.Bool {
+		return reflect.Value{}, reflect.Value{}, false
+	}
+	return v.Field(0), v.Field(1), true
+}
+
+// castToNullable casts a value into a nullable struct such as sql.NullInt64 and sql.Null[T] with the range checks
+// of the value field type. A NULL value sets Valid to false. It returns false if the destination is not a nullable struct.
+func castToNullable(from any, to any, cast func(from any, to any) error) (bool, error) {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return false, nil
+	}
+	value, valid, ok := nullableValue(tv.Elem())
+	if !ok {
+		return false, nil
+	}
+	if isNull(from) {
+		tv.Elem().SetZero()
+		return true, nil
+	}
+	if valuer, ok := from.(driver.Valuer); ok {
+		v, err := driverValue(valuer, to)
+		if err != nil {
+			return true, err
+		}
+		from = v
+	}
+	pv := reflect.New(value.Type())
+	if err := cast(from, pv.Interface()); err != nil {
+		return true, err
+	}
+	value.Set(pv.Elem())
+	valid.SetBool(true)
+	return true, nil
+}
+
+// castToScanner casts a value into a destination implementing sql.Scanner by calling Scan.
+// It returns false if the destination does not implement sql.Scanner.
+func castToScanner(from any, to any) (bool, error) {
+	scanner, ok := to.(sql.Scanner)
+	if !ok || isNil(scanner) {
+		return false, nil
+	}
+	if valuer, ok := from.(driver.Valuer); ok && !isNil(valuer) {
+		v, err := valuer.Value()
+		if err != nil {
+			return true, newErrorWithError(err, from, to)
+		}
+		from = v
+	}
+	if err := scanner.Scan(from); err != nil {
+		return true, newErrorWithError(err, from, to)
+	}
+	return true, nil
+}
@@ -15,83 +15,86 @@
 package safecast
 
 import (
+	"database/sql/driver"
+	"encoding"
 	"fmt"
 	"strconv"
 )
 
 // FromString casts an interface to a string type.
+// A destination implementing encoding.TextUnmarshaler is filled with UnmarshalText.
 func FromString(from string, to any) error {
 	switch to := to.(type) {
 	case *int:
 		v, err := strconv.ParseInt(from, 10, 64)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = int(v)
 	case *int8:
 		v, err := strconv.ParseInt(from, 10, 8)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = int8(v)
 	case *int16:
 		v, err := strconv.ParseInt(from, 10, 16)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = int16(v)
 	case *int32:
 		v, err := strconv.ParseInt(from, 10, 32)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = int32(v)
 	case *int64:
 		v, err := strconv.ParseInt(from, 10, 64)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = v
 	case *uint:
 		v, err := strconv.ParseUint(from, 10, 64)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = uint(v)
 	case *uint8:
 		v, err := strconv.ParseUint(from, 10, 8)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = uint8(v)
 	case *uint16:
 		v, err := strconv.ParseUint(from, 10, 16)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = uint16(v)
 	case *uint32:
 		v, err := strconv.ParseUint(from, 10, 32)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = uint32(v)
 	case *uint64:
 		v, err := strconv.ParseUint(from, 10, 64)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = v
 	case *float32:
 		v, err := strconv.ParseFloat(from, 32)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = float32(v)
 	case *float64:
 		v, err := strconv.ParseFloat(from, 64)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = v
 	case *string:
@@ -101,16 +104,20 @@ func FromString(from string, to any) error {
 	case *bool:
 		v, err := strconv.ParseBool(from)
 		if err != nil {
-			return newErrorWithError(err)
+			return newErrorWithError(err, from, to)
 		}
 		*to = v
 	default:
+		if ok, err := unmarshalText(from, to); ok {
+			return err
+		}
 		return newErrorCast(from, to)
 	}
 	return nil
 }
 
 // ToString casts an interface to a string type.
+// A value implementing encoding.TextMarshaler is cast with MarshalText in preference to fmt.Stringer.
 func ToString(from any, to *string) error {
 	switch from := from.(type) {
 	case int:
@@ -145,6 +152,22 @@ func ToString(from any, to *string) error {
 		*to = *from
 	case []byte:
 		*to = string(from)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToString(v, to)
+	case encoding.TextMarshaler:
+		b, ok, err := marshalText(from)
+		if err != nil {
+			return newErrorWithError(err, from, to)
+		}
+		if !ok {
+			*to = fmt.Sprintf("%v", from)
+			break
+		}
+		*to = string(b)
 	case fmt.Stringer:
 		*to = from.String()
 	case nil:
@@ -0,0 +1,102 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"encoding"
+	"time"
+)
+
+// marshalText returns the text encoding of the value if it implements encoding.TextMarshaler.
+// It returns false if the value does not implement the interface or is a nil pointer.
+// time.Time is excluded to keep its own string format.
+func marshalText(from any) ([]byte, bool, error) {
+	switch from.(type) {
+	case time.Time, *time.Time:
+		return nil, false, nil
+	}
+	m, ok := from.(encoding.TextMarshaler)
+	if !ok || isNil(m) {
+		return nil, false, nil
+	}
+	b, err := m.MarshalText()
+	return b, true, err
+}
+
+// unmarshalText decodes a string or a byte slice into the destination if it implements encoding.TextUnmarshaler.
+// It returns false if the destination does not implement the interface or the value is not a text.
+// time.Time is excluded to parse it with the supported layouts.
+func unmarshalText(from any, to any) (bool, error) {
+	if _, ok := to.(*time.Time); ok {
+		return false, nil
+	}
+	u, ok := to.(encoding.TextUnmarshaler)
+	if !ok || isNil(u) {
+		return false, nil
+	}
+	var text []byte
+	switch v := from.(type) {
+	case string:
+		text = []byte(v)
+	case *string:
+		if v == nil {
+			return false, nil
+		}
+		text = []byte(*v)
+	case []byte:
+		text = v
+	default:
+		bv, ok := toUnderlyingBuiltin(from)
+		if !ok {
+			return false, nil
+		}
+		switch bv := bv.(type) {
+		case string:
+			text = []byte(bv)
+		case []byte:
+			text = bv
+		default:
+			return false, nil
+		}
+	}
+	if err := u.UnmarshalText(text); err != nil {
+		return true, newErrorWithError(err, from, to)
+	}
+	return true, nil
+}
+
+// castText casts a value with encoding.TextMarshaler into a string or a byte slice,
+// or a text into a destination with encoding.TextUnmarshaler. It returns false if neither applies.
+// The text encodings take precedence over the underlying kinds of defined types such as net.IP.
+func castText(from any, to any) (bool, error) {
+	switch to := to.(type) {
+	case *string, *[]byte:
+		b, ok, err := marshalText(from)
+		if !ok {
+			return false, nil
+		}
+		if err != nil {
+			return true, newErrorWithError(err, from, to)
+		}
+		switch to := to.(type) {
+		case *string:
+			*to = string(b)
+		case *[]byte:
+			*to = b
+		}
+		return true, nil
+	}
+	return unmarshalText(from, to)
+}
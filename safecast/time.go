@@ -15,6 +15,10 @@
 package safecast
 
 import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -40,19 +44,81 @@ var SupportedTimeLayouts = []string{
 }
 
 // ToTime casts an interface to a time.Time.
+// A string is parsed as ISO 8601 by ParseISO8601 and then with SupportedTimeLayouts, or only with the layouts if they are specified.
+// A string without a time zone is interpreted as UTC, or in the location set by SetDefaultLocation.
+// A string with a time zone abbreviation other than UTC and GMT is accepted only if the abbreviation is registered
+// by RegisterZoneAbbreviation.
+// An integer or a float is cast as a Unix epoch number with the unit detected from its magnitude, see ToUnixTime.
+// A numeric string such as "20240102" is parsed only as a time string, use ToUnixTime or WithTimeUnit to cast it as a Unix epoch number.
+// An error naming the missing unit is returned for a numeric string such as "1700000000.123" which is not a time string.
 func ToTime(from any, to *time.Time, layouts ...string) error {
+	return newConfig(WithTimeLayouts(layouts...)).toTime(from, to)
+}
+
+// ToTimeInLocation casts an interface to a time.Time like ToTime, but a string without a time zone is interpreted
+// in the location like time.ParseInLocation, and a Unix epoch number is cast to a time.Time in the location.
+func ToTimeInLocation(from any, to *time.Time, loc *time.Location, layouts ...string) error {
+	return newConfig(WithTimeLayouts(layouts...), WithLocation(loc)).toTime(from, to)
+}
+
+// parseTime parses a time string with the layouts, or as ISO 8601 and then with SupportedTimeLayouts if no layouts are set. A string without a time zone is interpreted
+// in the location, or as UTC if the location is nil. The error of an ISO 8601 string with a value out of range, such as 2024-02-30,
+// and the error of an untrusted time zone abbreviation take precedence over the errors of the layouts which do not match.
+func (cfg *config) parseTime(s string, loc *time.Location) (time.Time, error) {
+	layouts := cfg.timeLayouts
+	if len(layouts) == 0 {
+		t, err := ParseISO8601(s, loc)
+		if err == nil || errors.Is(err, errISO8601Range) {
+			return t, err
+		}
+		layouts = SupportedTimeLayouts
+	}
+	var t time.Time
+	var err, zoneErr error
+	for _, layout := range layouts {
+		if loc == nil {
+			t, err = time.Parse(layout, s)
+		} else {
+			t, err = time.ParseInLocation(layout, s, loc)
+		}
+		if err != nil {
+			continue
+		}
+		t, err = cfg.resolveZone(t, loc, layout)
+		if err == nil {
+			return t, nil
+		}
+		zoneErr = err
+	}
+	if zoneErr != nil {
+		return time.Time{}, zoneErr
+	}
+	return time.Time{}, err
+}
+
+// toTime casts an interface to a time.Time with the configuration. A string without a time zone and a Unix epoch number
+// are interpreted in the location, and the unit of a Unix epoch number is detected from its magnitude if it is not set.
+// A numeric string is cast as a Unix epoch number only if the unit is set, and an error naming the missing unit is returned
+// if it is not a time string either.
+func (cfg *config) toTime(from any, to *time.Time) error {
+	loc := cfg.timeLocation()
 	parseTimeString := func(s string, to *time.Time) error {
-		var err error
-		if len(layouts) == 0 {
-			layouts = SupportedTimeLayouts
+		if cfg.timeUnit != 0 && isDecimalString(s) {
+			return toUnixTime(s, to, loc, cfg.timeUnit)
 		}
-		for _, layout := range layouts {
-			*to, err = time.Parse(layout, s)
-			if err == nil {
-				return nil
+		t, err := cfg.parseTime(s, loc)
+		if err != nil {
+			var castErr *CastError
+			if errors.As(err, &castErr) {
+				return err
 			}
+			if isDecimalString(s) {
+				err = fmt.Errorf("parsing time %q: a numeric string needs a Unix time unit, use ToUnixTime or WithTimeUnit", s)
+			}
+			return newErrorWithError(err, s, to)
 		}
-		return err
+		*to = t
+		return nil
 	}
 	switch from := from.(type) {
 	case time.Time:
@@ -67,6 +133,79 @@ func ToTime(from any, to *time.Time, layouts ...string) error {
 		return parseTimeString(*from, to)
 	case []byte:
 		return parseTimeString(string(from), to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return cfg.toTime(v, to)
+	}
+	return toUnixTime(from, to, loc, cfg.timeUnit)
+}
+
+// FromTime casts a time.Time to an interface type.
+// An integer destination receives the Unix time in the unit which defaults to time.Second, and the fraction of the unit
+// is truncated toward the past like time.Time.Unix. A float destination receives the Unix time in the unit with the fraction.
+// A string destination receives the time formatted with the first layout, or by time.Time.String if no layout is set.
+// WithTimeUnit, WithTimeLayouts and WithLocation set the unit, the layout and the location.
+func FromTime(from time.Time, to any, opts ...Option) error {
+	return newConfig(opts...).fromTime(from, to)
+}
+
+// fromTime casts a time.Time to an interface type with the configuration.
+func (cfg *config) fromTime(from time.Time, to any) error {
+	unit := time.Second
+	if cfg.timeUnit != 0 {
+		unit = cfg.timeUnit
+	}
+	if unit < 0 {
+		return newErrorCast(from, to)
+	}
+	if cfg.location != nil {
+		from = from.In(cfg.location)
+	}
+	format := func() string {
+		if 0 < len(cfg.timeLayouts) {
+			return from.Format(cfg.timeLayouts[0])
+		}
+		return from.String()
+	}
+	ns := new(big.Int).Mul(big.NewInt(from.Unix()), big.NewInt(int64(time.Second)))
+	ns.Add(ns, big.NewInt(int64(from.Nanosecond())))
+
+	switch to := to.(type) {
+	case *time.Time:
+		*to = from
+	case *string:
+		*to = format()
+	case *[]byte:
+		*to = []byte(format())
+	case *time.Duration:
+		return newErrorCast(from, to)
+	case *float32, *float64, *big.Float, *big.Rat:
+		return fromBig(new(big.Rat).SetFrac(ns, big.NewInt(int64(unit))), to)
+	case *int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64, *big.Int:
+		return fromBig(new(big.Int).Div(ns, big.NewInt(int64(unit))), to)
+	default:
+		if ok, err := castToUnderlyingBuiltin(from, to, func(from any, to any) error {
+			return cfg.fromTime(from.(time.Time), to)
+		}); ok {
+			return err
+		}
+		return newErrorCast(from, to)
+	}
+	return nil
+}
+
+// timeValue returns the time of a time.Time or a non-nil *time.Time value.
+func timeValue(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
 	}
-	return newErrorCast(from, to)
+	return time.Time{}, false
 }
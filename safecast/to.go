@@ -15,11 +15,68 @@
 package safecast
 
 import (
+	"encoding/json"
+	"math/big"
 	"time"
 )
 
 // To casts an interface to an interface type.
+// Defined types such as `type UserID int64` are cast through the builtin type with the same underlying kind.
+// The text encodings of encoding.TextMarshaler and encoding.TextUnmarshaler take precedence over the underlying kinds.
+// The destinations implementing sql.Scanner and the sources implementing driver.Valuer such as sql.NullInt64 are supported.
+// The converters registered by RegisterConverter are consulted before the builtin conversions.
 func To(from any, to any) error {
+	return ToWith(from, to)
+}
+
+// ToWith casts an interface to an interface type like To with the options such as WithRoundingMode,
+// which change the default conversion behavior.
+func ToWith(from any, to any, opts ...Option) error {
+	if 0 < len(opts) {
+		return newConfig(opts...).to(from, to)
+	}
+	return defaultConfig.castTo(from, to)
+}
+
+// castTo casts an interface to an interface type like To, and casts the value field of a nullable struct,
+// the underlying type of a defined type and a json.Number with the configuration.
+func (cfg *config) castTo(from any, to any) error {
+	from, ok, err := defaultConverters.cast(from, to)
+	if ok || err != nil {
+		return err
+	}
+	if ok, err := castText(from, to); ok {
+		return err
+	}
+	if ok, err := castToNullable(from, to, cfg.to); ok {
+		return err
+	}
+	if ok, err := castToScanner(from, to); ok {
+		return err
+	}
+	if n, ok := from.(json.Number); ok {
+		return fromJSONNumber(n, to, cfg.to)
+	}
+	switch v := from.(type) {
+	case time.Duration:
+		return FromDuration(v, to)
+	case *time.Duration:
+		return FromDuration(*v, to)
+	}
+	if t, ok := timeValue(from); ok {
+		return FromTime(t, to)
+	}
+	if isStringerToString(from, to) {
+		if s, ok := to.(*string); ok {
+			return ToString(from, s)
+		}
+		if ok, err := castToUnderlyingBuiltin(from, to, cfg.to); ok {
+			return err
+		}
+	}
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
 	switch to := to.(type) {
 	case *int:
 		return ToInt(from, to)
@@ -53,7 +110,18 @@ func To(from any, to any) error {
 		return ToBytes(from, to)
 	case *time.Time:
 		return ToTime(from, to)
+	case *time.Duration:
+		return ToDuration(from, to)
+	case *big.Int:
+		return ToBigInt(from, to)
+	case *big.Float:
+		return ToBigFloat(from, to)
+	case *big.Rat:
+		return ToBigRat(from, to)
 	default:
+		if ok, err := castToUnderlyingBuiltin(from, to, cfg.to); ok {
+			return err
+		}
 		return newErrorCast(from, to)
 	}
 }
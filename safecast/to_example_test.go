@@ -15,8 +15,12 @@
 package safecast
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+	"net/netip"
 	"time"
 )
 
@@ -655,3 +659,181 @@ func ExampleToBytes() {
 	// Output:
 	// abc
 }
+
+func ExampleTo_definedType() {
+	type Status uint8
+
+	var status Status
+	if err := To("200", &status); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println(status)
+	}
+
+	if err := To(Status(200), &status); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println(status)
+	}
+
+	if err := To(300, &status); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println(status)
+	}
+
+	// Output:
+	// 200
+	// 200
+	// cast error : out of range 300 > *uint8
+}
+
+func ExampleTo_textUnmarshaler() {
+	var addr netip.Addr
+	if err := To("10.0.0.1", &addr); err == nil {
+		fmt.Println(addr.Is4())
+	}
+
+	var s string
+	if err := To(addr, &s); err == nil {
+		fmt.Println(s)
+	}
+
+	// Output:
+	// true
+	// 10.0.0.1
+}
+
+func ExampleTo_sql() {
+	var to int8
+	if err := To(sql.NullInt64{Int64: 100, Valid: true}, &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := To(sql.NullInt64{Valid: false}, &to); err != nil {
+		fmt.Println(err)
+	}
+
+	var n sql.NullInt32
+	if err := To("42", &n); err == nil {
+		fmt.Println(n.Int32, n.Valid)
+	}
+
+	// Output:
+	// 100
+	// cast error : sql.NullInt64 ({0 false}) => *int8
+	// 42 true
+}
+
+func ExampleToUint64_jsonNumber() {
+	var to uint64
+	if err := ToUint64(json.Number("18446744073709551615"), &to); err == nil {
+		fmt.Println(to)
+	}
+
+	// Output:
+	// 18446744073709551615
+}
+
+func ExampleToBigInt() {
+	var to big.Int
+	if err := ToBigInt(uint64(math.MaxUint64), &to); err == nil {
+		fmt.Println(to.String())
+	}
+
+	var i8 int8
+	if err := To(big.NewInt(128), &i8); err != nil {
+		fmt.Println(err)
+	}
+
+	if err := To(big.NewRat(1, 2), &i8); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 18446744073709551615
+	// cast error : out of range 128 > *int8
+	// cast error : fractional 1/2 => *int8
+}
+
+func ExampleToDuration() {
+	var to time.Duration
+	if err := ToDuration("1h30m", &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToDuration(90, &to, time.Second); err == nil {
+		fmt.Println(to)
+	}
+
+	if cmp, err := Compare(2*time.Second, "2s"); err == nil {
+		fmt.Println(cmp)
+	}
+
+	// Output:
+	// 1h30m0s
+	// 1m30s
+	// 0
+}
+
+func ExampleToUnixTime() {
+	var to time.Time
+	if err := ToUnixTime(int64(1700000000), &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToUnixTime("1700000000123", &to, time.Millisecond); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToTime(1700000000.5, &to); err == nil {
+		fmt.Println(to)
+	}
+
+	if err := ToUnixTime(int64(math.MaxInt64), &to, time.Second); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 2023-11-14 22:13:20 +0000 UTC
+	// 2023-11-14 22:13:20.123 +0000 UTC
+	// 2023-11-14 22:13:20.5 +0000 UTC
+	// cast error : out of range 9223372036854775807 > *time.Time
+}
+
+func ExampleToTimeInLocation() {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	var to time.Time
+	if err := ToTimeInLocation("2024-01-02 15:04:05", &to, jst); err == nil {
+		fmt.Println(to.UTC())
+	}
+
+	if err := ToTime("2024-01-02 15:04:05 JST", &to); err != nil {
+		fmt.Println(err)
+	}
+
+	if err := ToWith("2024-01-02 15:04:05 JST", &to, WithZoneAbbreviation("JST", jst)); err == nil {
+		fmt.Println(to.UTC())
+	}
+
+	// Output:
+	// 2024-01-02 06:04:05 +0000 UTC
+	// cast error : unknown time zone abbreviation "JST"
+	// 2024-01-02 06:04:05 +0000 UTC
+}
+
+func ExampleParseISO8601() {
+	for _, s := range []string{"20240102T150405Z", "2024-W12-3", "2024-045", "2024-05", "2024-01-02T15:04:05,5+09:00"} {
+		if t, err := ParseISO8601(s, nil); err == nil {
+			fmt.Println(t.UTC())
+		}
+	}
+
+	// Output:
+	// 2024-01-02 15:04:05 +0000 UTC
+	// 2024-03-20 00:00:00 +0000 UTC
+	// 2024-02-14 00:00:00 +0000 UTC
+	// 2024-05-01 00:00:00 +0000 UTC
+	// 2024-01-02 06:04:05.5 +0000 UTC
+}
@@ -15,8 +15,11 @@
 package safecast
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -191,7 +194,7 @@ func ToUint8(from any, to *uint8) error {
 		if err == nil {
 			return *to, ToUint8(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -273,13 +276,13 @@ func ToUint8(from any, to *uint8) error {
 			return err
 		}
 	case float32:
-		return ToUint8(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToUint8(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToUint8(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToUint8(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -296,6 +299,16 @@ func ToUint8(from any, to *uint8) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToUint8(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -382,7 +395,7 @@ func ToUint16(from any, to *uint16) error {
 		if err == nil {
 			return *to, ToUint16(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -460,13 +473,13 @@ func ToUint16(from any, to *uint16) error {
 			return err
 		}
 	case float32:
-		return ToUint16(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToUint16(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToUint16(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToUint16(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -483,6 +496,16 @@ func ToUint16(from any, to *uint16) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToUint16(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -562,7 +585,7 @@ func ToUint32(from any, to *uint32) error {
 		if err == nil {
 			return *to, ToUint32(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -636,13 +659,13 @@ func ToUint32(from any, to *uint32) error {
 			return err
 		}
 	case float32:
-		return ToUint32(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToUint32(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToUint32(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToUint32(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -659,6 +682,16 @@ func ToUint32(from any, to *uint32) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToUint32(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -718,7 +751,7 @@ func ToUint64(from any, to *uint64) error {
 		if err == nil {
 			return *to, ToUint64(fv, to)
 		}
-		return 0, newErrorCast(from, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -784,13 +817,13 @@ func ToUint64(from any, to *uint64) error {
 	case *uint64:
 		*to = *from
 	case float32:
-		return ToUint64(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToUint64(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToUint64(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToUint64(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -807,6 +840,16 @@ func ToUint64(from any, to *uint64) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToUint64(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -866,7 +909,7 @@ func ToUint(from any, to *uint) error {
 		if err == nil {
 			return *to, ToUint(fv, to)
 		}
-		return 0, newErrorCast(v, to)
+		return 0, newErrorWithError(err, v, to)
 	}
 
 	var err error
@@ -933,13 +976,13 @@ func ToUint(from any, to *uint) error {
 	case *uint64:
 		*to = uint(*from)
 	case float32:
-		return ToUint(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float32:
-		return ToUint(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case float64:
-		return ToUint(int64(from), to)
+		return FromFloat64(float64(from), to)
 	case *float64:
-		return ToUint(int64(*from), to)
+		return FromFloat64(float64(*from), to)
 	case bool:
 		*to = fromBool(from)
 	case *bool:
@@ -956,6 +999,16 @@ func ToUint(from any, to *uint) error {
 		if *to, err = fromString(string(from)); err != nil {
 			return err
 		}
+	case json.Number:
+		return fromJSONNumber(from, to, To)
+	case *big.Int, *big.Float, *big.Rat:
+		return fromBig(from, to)
+	case driver.Valuer:
+		v, err := driverValue(from, to)
+		if err != nil {
+			return err
+		}
+		return ToUint(v, to)
 	default:
 		return newErrorCast(from, to)
 	}
@@ -0,0 +1,122 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"errors"
+	"reflect"
+)
+
+// integerBits returns the two's complement bits of an integer value.
+// It returns false if the value is not an integer.
+func integerBits(from any) (uint64, bool) {
+	if v, ok := toUnderlyingBuiltin(from); ok {
+		from = v
+	}
+	switch from := from.(type) {
+	case int:
+		return uint64(from), true
+	case *int:
+		return uint64(*from), true
+	case int8:
+		return uint64(from), true
+	case *int8:
+		return uint64(*from), true
+	case int16:
+		return uint64(from), true
+	case *int16:
+		return uint64(*from), true
+	case int32:
+		return uint64(from), true
+	case *int32:
+		return uint64(*from), true
+	case int64:
+		return uint64(from), true
+	case *int64:
+		return uint64(*from), true
+	case uint:
+		return uint64(from), true
+	case *uint:
+		return uint64(*from), true
+	case uint8:
+		return uint64(from), true
+	case *uint8:
+		return uint64(*from), true
+	case uint16:
+		return uint64(from), true
+	case *uint16:
+		return uint64(*from), true
+	case uint32:
+		return uint64(from), true
+	case *uint32:
+		return uint64(*from), true
+	case uint64:
+		return from, true
+	case *uint64:
+		return *from, true
+	}
+	return 0, false
+}
+
+// setWrappedBits sets the low-order bits of the two's complement bits to the integer destination.
+// It returns false if the destination is not a pointer to an integer type.
+func setWrappedBits(bits uint64, to any) bool {
+	tv := reflect.ValueOf(to)
+	if !tv.IsValid() || tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return false
+	}
+	ev := tv.Elem()
+	switch ev.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		shift := 64 - ev.Type().Bits()
+		ev.SetInt(int64(bits<<shift) >> shift)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		shift := 64 - ev.Type().Bits()
+		ev.SetUint(bits << shift >> shift)
+	default:
+		return false
+	}
+	return true
+}
+
+// Wrap casts an integer to an integer type with the two's complement wraparound, the modular arithmetic
+// semantics of the Go conversion such as int8(x), instead of returning an out-of-range error.
+// It returns true if the value is wrapped, that is, the result is not equal to the source value.
+// A value which is not an integer, or a destination which is not an integer type, returns an error.
+func Wrap(from any, to any) (bool, error) {
+	err := To(from, to)
+	if err == nil {
+		return false, nil
+	}
+
+	var castErr *CastError
+	if !errors.As(err, &castErr) {
+		return false, err
+	}
+	switch castErr.Reason {
+	case ReasonOverflow, ReasonUnderflow:
+	default:
+		return false, err
+	}
+
+	bits, ok := integerBits(from)
+	if !ok {
+		return false, err
+	}
+	if !setWrappedBits(bits, to) {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+)
+
+func ExampleWrap() {
+	var to int8
+
+	wrapped, _ := Wrap(128, &to)
+	fmt.Println(to, wrapped)
+
+	wrapped, _ = Wrap(100, &to)
+	fmt.Println(to, wrapped)
+
+	var u8 uint8
+	wrapped, _ = Wrap(-1, &u8)
+	fmt.Println(u8, wrapped)
+
+	// Output:
+	// -128 true
+	// 100 false
+	// 255 true
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safecast
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type zoneRegistry struct {
+	sync.RWMutex
+	location *time.Location
+	abbrs    map[string]*time.Location
+}
+
+var defaultZones = &zoneRegistry{
+	abbrs: map[string]*time.Location{},
+}
+
+// SetDefaultLocation sets the location used when a string without a time zone, or a Unix epoch number, is cast to a time.Time
+// and no location is specified by ToTimeInLocation or WithLocation. A nil location restores the default, UTC.
+// Since the setting affects all conversions in the process, prefer ToTimeInLocation or WithLocation for a Caster.
+// It returns the previous location, so the setting can be restored, for example at the end of a test.
+// The setting is safe for concurrent use.
+func SetDefaultLocation(loc *time.Location) *time.Location {
+	defaultZones.Lock()
+	defer defaultZones.Unlock()
+	prev := defaultZones.location
+	defaultZones.location = loc
+	return prev
+}
+
+// RegisterZoneAbbreviation registers a time zone abbreviation such as "JST" as trusted with the location which it stands for,
+// and a time string with the abbreviation is interpreted in the location. The time package resolves only UTC, GMT and
+// the abbreviations of the location used to parse a string, and fabricates a location with zero offset for others,
+// so a string with an abbreviation which is neither resolved nor registered returns ErrSyntax.
+// A nil location unregisters the abbreviation. Since the registration affects all conversions in the process,
+// prefer WithZoneAbbreviation for a Caster or a single conversion. It returns the previously registered location,
+// or nil if the abbreviation was not registered, so the registration can be restored, for example at the end of a test.
+// The registration is safe for concurrent use.
+func RegisterZoneAbbreviation(abbr string, loc *time.Location) *time.Location {
+	defaultZones.Lock()
+	defer defaultZones.Unlock()
+	prev := defaultZones.abbrs[abbr]
+	if loc == nil {
+		delete(defaultZones.abbrs, abbr)
+		return prev
+	}
+	defaultZones.abbrs[abbr] = loc
+	return prev
+}
+
+// WithZoneAbbreviation registers a trusted time zone abbreviation only for a Caster or a single conversion.
+// The abbreviation takes precedence over the one registered by RegisterZoneAbbreviation, and a nil location
+// distrusts the abbreviation for the conversions with the option even if it is registered by RegisterZoneAbbreviation.
+func WithZoneAbbreviation(abbr string, loc *time.Location) Option {
+	return func(cfg *config) {
+		if cfg.zoneAbbrs == nil {
+			cfg.zoneAbbrs = map[string]*time.Location{}
+		}
+		cfg.zoneAbbrs[abbr] = loc
+	}
+}
+
+// timeLocation returns the location used to parse a string without a time zone, or nil for UTC.
+func (cfg *config) timeLocation() *time.Location {
+	if cfg.location != nil {
+		return cfg.location
+	}
+	defaultZones.RLock()
+	defer defaultZones.RUnlock()
+	return defaultZones.location
+}
+
+// zoneAbbreviation returns the location of a trusted time zone abbreviation.
+func (cfg *config) zoneAbbreviation(abbr string) (*time.Location, bool) {
+	if loc, ok := cfg.zoneAbbrs[abbr]; ok {
+		return loc, loc != nil
+	}
+	defaultZones.RLock()
+	defer defaultZones.RUnlock()
+	loc, ok := defaultZones.abbrs[abbr]
+	return loc, ok
+}
+
+// resolveZone interprets a time parsed with a time zone abbreviation in the location of the trusted abbreviation.
+// A numeric offset parsed with the layout must match the offset of the location at the time.
+// It returns an error if the time package fabricated a location with zero offset for an unknown abbreviation.
+func (cfg *config) resolveZone(t time.Time, loc *time.Location, layout string) (time.Time, error) {
+	name, offset := t.Zone()
+	if name == "" || t.Location() == time.UTC || t.Location() == time.Local || (loc != nil && t.Location() == loc) {
+		return t, nil
+	}
+	hasOffset := layoutHasOffset(layout)
+	if zl, ok := cfg.zoneAbbreviation(name); ok {
+		zt := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), zl)
+		if _, zoneOffset := zt.Zone(); hasOffset && zoneOffset != offset {
+			return time.Time{}, fmt.Errorf("time zone abbreviation %q does not match the offset %s", name, t.Format("-07:00"))
+		}
+		return zt, nil
+	}
+	if hasOffset || offset != 0 || name == "GMT" {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("unknown time zone abbreviation %q", name)
+}
+
+// layoutHasOffset returns true if the layout has a numeric time zone offset such as -0700 or Z07:00.
+func layoutHasOffset(layout string) bool {
+	return strings.Contains(layout, "-07") || strings.Contains(layout, "Z07")
+}
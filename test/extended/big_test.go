@@ -0,0 +1,168 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func bigIntString(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big.Int %q", s)
+	}
+	return v
+}
+
+func TestBigSources(t *testing.T) {
+	tests := []struct {
+		from any
+		to   any
+		want any
+		err  error
+	}{
+		{big.NewInt(127), new(int8), int8(127), nil},
+		{big.NewInt(128), new(int8), nil, safecast.ErrOverflow},
+		{big.NewInt(-129), new(int8), nil, safecast.ErrUnderflow},
+		{big.NewInt(-1), new(uint64), nil, safecast.ErrUnderflow},
+		{new(big.Int).SetUint64(math.MaxUint64), new(uint64), uint64(math.MaxUint64), nil},
+		{new(big.Int).Lsh(big.NewInt(1), 64), new(uint64), nil, safecast.ErrOverflow},
+		{new(big.Int).Lsh(big.NewInt(1), 64), new(int), nil, safecast.ErrOverflow},
+		{new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 64)), new(int64), nil, safecast.ErrUnderflow},
+		{new(big.Int).Lsh(big.NewInt(1), 64), new(float64), float64(1 << 64), nil},
+		{new(big.Int).Lsh(big.NewInt(1), 200), new(float32), nil, safecast.ErrOverflow},
+		{new(big.Int).Lsh(big.NewInt(1), 2000), new(float64), nil, safecast.ErrOverflow},
+		{big.NewRat(10, 2), new(uint8), uint8(5), nil},
+		{big.NewRat(1, 2), new(int32), nil, safecast.ErrFractional},
+		{big.NewRat(1, 2), new(float64), 0.5, nil},
+		{big.NewRat(-600, 2), new(int8), nil, safecast.ErrUnderflow},
+		{big.NewFloat(2.75), new(int16), int16(2), nil},
+		{big.NewFloat(2.75), new(float32), float32(2.75), nil},
+		{new(big.Float).SetInf(false), new(int64), nil, safecast.ErrInfinity},
+		{big.NewFloat(1e40), new(uint32), nil, safecast.ErrOverflow},
+		{big.NewInt(1), new(bool), true, nil},
+		{big.NewInt(42), new(string), "42", nil},
+		{big.NewRat(1, 3), new(string), "1/3", nil},
+		{(*big.Int)(nil), new(int), nil, safecast.ErrNil},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v=>%T", test.from, test.to), func(t *testing.T) {
+			for name, cast := range map[string]func(any, any) error{"To": safecast.To, "From": safecast.From} {
+				err := cast(test.from, test.to)
+				if test.err != nil {
+					if !errors.Is(err, test.err) {
+						t.Errorf("%s() = %v, want %v", name, err, test.err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("%s() = %v", name, err)
+					continue
+				}
+				if got := reflect.ValueOf(test.to).Elem().Interface(); got != test.want {
+					t.Errorf("%s() = %v, want %v", name, got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBigDestinations(t *testing.T) {
+	var i big.Int
+	if err := safecast.ToBigInt(uint64(math.MaxUint64), &i); err != nil || i.String() != "18446744073709551615" {
+		t.Errorf("ToBigInt() = %v, %v", &i, err)
+	}
+	if err := safecast.To("-123456789012345678901234567890", &i); err != nil || i.String() != "-123456789012345678901234567890" {
+		t.Errorf("To() = %v, %v", &i, err)
+	}
+	if err := safecast.ToBigInt(-2.9, &i); err != nil || i.Int64() != -2 {
+		t.Errorf("ToBigInt() = %v, %v", &i, err)
+	}
+	if err := safecast.ToBigInt(math.NaN(), &i); !errors.Is(err, safecast.ErrNaN) {
+		t.Errorf("ToBigInt() = %v, want ErrNaN", err)
+	}
+	if err := safecast.ToBigInt("1.5", &i); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("ToBigInt() = %v, want ErrSyntax", err)
+	}
+	if err := safecast.ToBigInt(big.NewRat(3, 2), &i); !errors.Is(err, safecast.ErrFractional) {
+		t.Errorf("ToBigInt() = %v, want ErrFractional", err)
+	}
+	if err := safecast.From(int8(-5), &i); err != nil || i.Int64() != -5 {
+		t.Errorf("From() = %v, %v", &i, err)
+	}
+
+	var r big.Rat
+	if err := safecast.ToBigRat(0.1, &r); err != nil || r.Cmp(new(big.Rat).SetFloat64(0.1)) != 0 {
+		t.Errorf("ToBigRat() = %v, %v", &r, err)
+	}
+	if err := safecast.To("1/3", &r); err != nil || r.Cmp(big.NewRat(1, 3)) != 0 {
+		t.Errorf("To() = %v, %v", &r, err)
+	}
+	if err := safecast.ToBigRat(math.Inf(1), &r); !errors.Is(err, safecast.ErrInfinity) {
+		t.Errorf("ToBigRat() = %v, want ErrInfinity", err)
+	}
+
+	var f big.Float
+	if err := safecast.ToBigFloat(bigIntString(t, "123456789012345678901234567890"), &f); err != nil || f.Sign() <= 0 {
+		t.Errorf("ToBigFloat() = %v, %v", &f, err)
+	}
+	if err := safecast.From(1.5, &f); err != nil || f.String() != "1.5" {
+		t.Errorf("From() = %v, %v", &f, err)
+	}
+
+	v, err := safecast.Cast[int64](bigIntString(t, "9223372036854775807"))
+	if err != nil || v != math.MaxInt64 {
+		t.Errorf("Cast() = %v, %v", v, err)
+	}
+}
+
+func TestBigCompare(t *testing.T) {
+	tests := []struct {
+		v1   any
+		v2   any
+		want int
+	}{
+		{big.NewInt(5), uint8(5), 0},
+		{uint8(5), big.NewInt(5), 0},
+		{big.NewInt(5), 5.5, -1},
+		{big.NewRat(1, 2), 0.5, 0},
+		{big.NewRat(1, 3), "0.3", 1},
+		{big.NewFloat(2.5), big.NewRat(5, 2), 0},
+		{bigIntString(t, "18446744073709551616"), uint64(math.MaxUint64), 1},
+		{big.NewInt(-1), uint64(0), -1},
+	}
+	for _, test := range tests {
+		cmp, err := safecast.Compare(test.v1, test.v2)
+		if err != nil {
+			t.Errorf("Compare(%v, %v) = %v", test.v1, test.v2, err)
+			continue
+		}
+		if cmp != test.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", test.v1, test.v2, cmp, test.want)
+		}
+	}
+	if !safecast.Equal(big.NewInt(5), 5) {
+		t.Errorf("Equal() = false")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestCast(t *testing.T) {
+	t.Run("integers", func(t *testing.T) {
+		if v, err := safecast.Cast[int8]("127"); err != nil || v != 127 {
+			t.Errorf("Cast[int8]() = %v, %v", v, err)
+		}
+		if v, err := safecast.Cast[int64](uint32(math.MaxUint32)); err != nil || v != math.MaxUint32 {
+			t.Errorf("Cast[int64]() = %v, %v", v, err)
+		}
+		if v, err := safecast.Cast[uint64]("18446744073709551615"); err != nil || v != math.MaxUint64 {
+			t.Errorf("Cast[uint64]() = %v, %v", v, err)
+		}
+	})
+
+	t.Run("range errors", func(t *testing.T) {
+		tests := []struct {
+			name string
+			cast func() error
+		}{
+			{"int8 overflow", func() error { _, err := safecast.Cast[int8](128); return err }},
+			{"int8 underflow", func() error { _, err := safecast.Cast[int8](-129); return err }},
+			{"uint8 negative", func() error { _, err := safecast.Cast[uint8](-1); return err }},
+			{"uint16 overflow", func() error { _, err := safecast.Cast[uint16](math.MaxUint16 + 1); return err }},
+			{"int32 invalid string", func() error { _, err := safecast.Cast[int32]("abc"); return err }},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.cast(); err == nil {
+					t.Errorf("expected error")
+				}
+			})
+		}
+	})
+
+	t.Run("zero value on error", func(t *testing.T) {
+		v, err := safecast.Cast[int8](1000)
+		if err == nil {
+			t.Errorf("expected error")
+		}
+		if v != 0 {
+			t.Errorf("Cast[int8]() = %v, want 0", v)
+		}
+	})
+
+	t.Run("non-numeric types", func(t *testing.T) {
+		if v, err := safecast.Cast[string](42); err != nil || v != "42" {
+			t.Errorf("Cast[string]() = %v, %v", v, err)
+		}
+		if v, err := safecast.Cast[bool]("true"); err != nil || !v {
+			t.Errorf("Cast[bool]() = %v, %v", v, err)
+		}
+		if v, err := safecast.Cast[float32]("1.5"); err != nil || v != 1.5 {
+			t.Errorf("Cast[float32]() = %v, %v", v, err)
+		}
+		if v, err := safecast.Cast[[]byte]("abc"); err != nil || !bytes.Equal(v, []byte("abc")) {
+			t.Errorf("Cast[[]byte]() = %v, %v", v, err)
+		}
+		want := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		if v, err := safecast.Cast[time.Time]("2022-01-01T00:00:00Z"); err != nil || !v.Equal(want) {
+			t.Errorf("Cast[time.Time]() = %v, %v", v, err)
+		}
+	})
+}
+
+func TestMustCast(t *testing.T) {
+	if v := safecast.MustCast[uint8]("255"); v != 255 {
+		t.Errorf("MustCast[uint8]() = %v, want 255", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustCast[uint8]() did not panic")
+		}
+	}()
+	safecast.MustCast[uint8]("256")
+}
+
+func TestCastOr(t *testing.T) {
+	if v := safecast.CastOr[int16](math.MaxInt16+1, -1); v != -1 {
+		t.Errorf("CastOr[int16]() = %v, want -1", v)
+	}
+	if v := safecast.CastOr[int16]("42", -1); v != 42 {
+		t.Errorf("CastOr[int16]() = %v, want 42", v)
+	}
+}
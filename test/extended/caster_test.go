@@ -0,0 +1,194 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestCasterDefault(t *testing.T) {
+	c := safecast.NewCaster()
+
+	var b bool
+	if err := c.To("1", &b); err != nil || !b {
+		t.Errorf("To(\"1\") = %v, %v", b, err)
+	}
+	var i int
+	if err := c.To(nil, &i); err == nil {
+		t.Errorf("To(nil) should fail")
+	}
+	if err := c.From(" 1", &i); err == nil {
+		t.Errorf("From(\" 1\") should fail")
+	}
+	if cmp, err := c.Compare(1, "1"); err != nil || cmp != 0 {
+		t.Errorf("Compare(1, \"1\") = %v, %v", cmp, err)
+	}
+	if !c.Equal(1, "1") {
+		t.Errorf("Equal(1, \"1\") = false")
+	}
+}
+
+func TestCasterStrictBoolParsing(t *testing.T) {
+	c := safecast.NewCaster(safecast.WithStrictBoolParsing())
+
+	for _, s := range []string{"true", "false"} {
+		var b bool
+		if err := c.To(s, &b); err != nil {
+			t.Error(err)
+		}
+		if b != (s == "true") {
+			t.Errorf("To(%q) = %v", s, b)
+		}
+	}
+	for _, s := range []string{"1", "0", "t", "TRUE", "yes"} {
+		var b bool
+		if err := c.To(s, &b); !errors.Is(err, safecast.ErrSyntax) {
+			t.Errorf("To(%q) = %v, want ErrSyntax", s, err)
+		}
+	}
+	var b bool
+	if err := c.To(1, &b); err != nil || !b {
+		t.Errorf("To(1) = %v, %v", b, err)
+	}
+	if _, err := c.Compare(true, "1"); err == nil {
+		t.Errorf("Compare(true, \"1\") should fail")
+	}
+	if c.Equal("t", true) {
+		t.Errorf("Equal(\"t\", true) = true")
+	}
+	if !c.Equal("true", true) {
+		t.Errorf("Equal(\"true\", true) = false")
+	}
+	// A nil string pointer returns ErrNil instead of panicking.
+	if err := c.To((*string)(nil), &b); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("To(nil) = %v, want ErrNil", err)
+	}
+	if err := safecast.ToWith((*string)(nil), &b, safecast.WithStrictBoolParsing()); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("ToWith(nil) = %v, want ErrNil", err)
+	}
+}
+
+func TestCasterTimeLayouts(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	c := safecast.NewCaster(
+		safecast.WithTimeLayouts("2006/01/02 15:04"),
+		safecast.WithLocation(tokyo),
+	)
+
+	want := time.Date(2024, 1, 2, 3, 4, 0, 0, tokyo)
+	var ts time.Time
+	if err := c.To("2024/01/02 03:04", &ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ts.Equal(want) {
+		t.Errorf("To() = %v, want %v", ts, want)
+	}
+	if err := c.To(time.Now().Format(time.RFC3339), &ts); err == nil {
+		t.Errorf("To(RFC3339) should fail with the custom layouts")
+	}
+	if !c.Equal(want, "2024/01/02 03:04") {
+		t.Errorf("Equal() = false")
+	}
+	if cmp, err := c.Compare("2024/01/02 03:05", want); err != nil || cmp != 1 {
+		t.Errorf("Compare() = %v, %v", cmp, err)
+	}
+
+	c = safecast.NewCaster(safecast.WithLocation(tokyo))
+	if err := c.To("2024-01-02 03:04:00", &ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ts.Equal(want) {
+		t.Errorf("To() = %v, want %v", ts, want)
+	}
+}
+
+func TestCasterRoundingMode(t *testing.T) {
+	c := safecast.NewCaster(safecast.WithRoundingMode(safecast.RoundHalfAwayFromZero))
+	var i int
+	if err := c.To(2.5, &i); err != nil || i != 3 {
+		t.Errorf("To(2.5) = %v, %v", i, err)
+	}
+	if err := c.From(-2.5, &i); err != nil || i != -3 {
+		t.Errorf("From(-2.5) = %v, %v", i, err)
+	}
+	// A string operand is cast to the numeric type of the other operand with the rounding mode,
+	// while numeric operands are compared exactly.
+	if cmp, err := c.Compare(3, 2.5); err != nil || cmp != 1 {
+		t.Errorf("Compare(3, 2.5) = %v, %v", cmp, err)
+	}
+	if cmp, err := c.Compare(3, "2.5"); err != nil || cmp != 0 {
+		t.Errorf("Compare(3, \"2.5\") = %v, %v", cmp, err)
+	}
+	if !c.Equal(3, "2.5") {
+		t.Errorf("Equal(3, \"2.5\") = false")
+	}
+	if safecast.Equal(3, "2.5") {
+		t.Errorf("safecast.Equal(3, \"2.5\") = true")
+	}
+}
+
+func TestCasterNilAsZero(t *testing.T) {
+	c := safecast.NewCaster(safecast.WithNilAsZero())
+
+	i := 10
+	if err := c.To(nil, &i); err != nil || i != 0 {
+		t.Errorf("To(nil) = %v, %v", i, err)
+	}
+	s := "abc"
+	var sp *string
+	if err := c.From(sp, &s); err != nil || s != "" {
+		t.Errorf("From(nil) = %q, %v", s, err)
+	}
+	ts := time.Now()
+	if err := c.To(nil, &ts); err != nil || !ts.IsZero() {
+		t.Errorf("To(nil) = %v, %v", ts, err)
+	}
+	if cmp, err := c.Compare(nil, 0); err != nil || cmp != 0 {
+		t.Errorf("Compare(nil, 0) = %v, %v", cmp, err)
+	}
+	if cmp, err := c.Compare(1, nil); err != nil || cmp != 1 {
+		t.Errorf("Compare(1, nil) = %v, %v", cmp, err)
+	}
+	if !c.Equal("", nil) {
+		t.Errorf("Equal(\"\", nil) = false")
+	}
+}
+
+func TestCasterTrimSpace(t *testing.T) {
+	c := safecast.NewCaster(safecast.WithTrimSpace())
+
+	var i int64
+	if err := c.To(" 42\n", &i); err != nil || i != 42 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	if err := c.To([]byte("\t-7 "), &i); err != nil || i != -7 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	var f float64
+	if err := c.From(" 1.5 ", &f); err != nil || f != 1.5 {
+		t.Errorf("From() = %v, %v", f, err)
+	}
+	var s string
+	if err := c.To("  abc  ", &s); err != nil || s != "abc" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if !c.Equal(" 42 ", 42) {
+		t.Errorf("Equal() = false")
+	}
+}
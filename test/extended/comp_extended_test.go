@@ -15,8 +15,13 @@
 package test
 
 import (
+	"fmt"
 	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
 	"testing"
+	"testing/quick"
 	"time"
 
 	"github.com/cybergarage/go-safecast/safecast"
@@ -58,10 +63,10 @@ func TestCompareExtended(t *testing.T) {
 
 		// Byte slice comparisons
 		{"bytes equal", []byte{1, 2, 3}, []byte{1, 2, 3}, 0, false},
-		{"bytes less", []byte{1, 2, 3}, []byte{1, 2, 4}, 1, false},     // Fixed: actual behavior
-		{"bytes greater", []byte{1, 2, 4}, []byte{1, 2, 3}, -1, false}, // Fixed: actual behavior
-		{"bytes shorter", []byte{1, 2}, []byte{1, 2, 3}, 1, false},     // Fixed: actual behavior
-		{"bytes longer", []byte{1, 2, 3}, []byte{1, 2}, -1, false},     // Fixed: actual behavior
+		{"bytes less", []byte{1, 2, 3}, []byte{1, 2, 4}, -1, false},
+		{"bytes greater", []byte{1, 2, 4}, []byte{1, 2, 3}, 1, false},
+		{"bytes shorter", []byte{1, 2}, []byte{1, 2, 3}, -1, false},
+		{"bytes longer", []byte{1, 2, 3}, []byte{1, 2}, 1, false},
 		{"empty bytes", []byte{}, []byte{}, 0, false},
 
 		// Pointer comparisons
@@ -72,13 +77,14 @@ func TestCompareExtended(t *testing.T) {
 		{"pointer time equal", func() any { t := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC); return &t }(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 0, false},
 
 		// Special float values
-		{"NaN comparison", math.NaN(), math.NaN(), 1, false}, // Fixed: NaN comparison returns 1
+		{"NaN comparison", math.NaN(), math.NaN(), 0, false},
+		{"NaN vs finite", math.NaN(), 100.0, -1, false},
 		{"Inf comparison", math.Inf(1), math.Inf(1), 0, false},
 		{"Inf vs finite", math.Inf(1), 100.0, 1, false},
 		{"finite vs Inf", 100.0, math.Inf(1), -1, false},
 
 		// Error cases
-		{"incompatible types", 42, "hello", 1, false}, // Fixed: returns 1
+		{"incompatible types", 42, "hello", -1, false},
 		{"unsupported type", []int{1, 2, 3}, []int{1, 2, 3}, 0, true},
 		{"struct comparison", struct{ A int }{42}, struct{ A int }{42}, 0, true},
 		{"nil comparison", nil, nil, 0, false}, // Fixed: nil comparison works
@@ -161,3 +167,179 @@ func TestCompareErrorCoverage(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareNumericCrossType(t *testing.T) {
+	tests := []struct {
+		a, b     any
+		expected int
+	}{
+		{int8(1), 300, -1},
+		{300, int8(1), 1},
+		{int8(-128), uint8(255), -1},
+		{-1, uint64(math.MaxUint64), -1},
+		{uint64(math.MaxUint64), int64(math.MaxInt64), 1},
+		{uint64(1 << 63), int64(math.MinInt64), 1},
+		{int64(math.MaxInt64), float64(1 << 63), -1},
+		{uint64(math.MaxUint64), float64(1 << 64), -1},
+		{int64(1<<53 + 1), float64(1 << 53), 1},
+		{uint8(200), -0.5, 1},
+		{int16(-3), float32(-2.5), -1},
+		{float32(3.14), 3.14, 0},
+		{0.1, float32(0.1), 0},
+		{float32(1e38), 1e300, -1},
+		{math.Inf(-1), int64(math.MinInt64), -1},
+		{func() any { v := uint16(7); return &v }(), int8(7), 0},
+		{UserID(-5), uint(5), -1},
+		{Ratio(0.5), uint8(0), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T(%v) vs %T(%v)", tt.a, tt.a, tt.b, tt.b), func(t *testing.T) {
+			result, err := safecast.Compare(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Compare(%v, %v) = %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Compare(%v, %v) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+			result, err = safecast.Compare(tt.b, tt.a)
+			if err != nil {
+				t.Fatalf("Compare(%v, %v) = %v", tt.b, tt.a, err)
+			}
+			if result != -tt.expected {
+				t.Errorf("Compare(%v, %v) = %v, want %v", tt.b, tt.a, result, -tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompareNumericSymmetricProperty(t *testing.T) {
+	values := []any{
+		int8(math.MinInt8), int8(-1), int8(0), int8(1), int8(math.MaxInt8),
+		int16(math.MinInt16), int16(300), int32(math.MinInt32), int32(math.MaxInt32),
+		int64(math.MinInt64), int64(-1), int64(math.MaxInt64), int(42),
+		uint8(0), uint8(math.MaxUint8), uint16(math.MaxUint16), uint32(math.MaxUint32),
+		uint64(1 << 63), uint64(math.MaxUint64), uint(42),
+		-1.5, 0.0, 0.5, 42.0, float64(1 << 63), float64(1 << 64), math.MaxFloat64, math.Inf(1), math.Inf(-1),
+	}
+	exact := func(v any) *big.Rat {
+		if f, ok := v.(float64); ok {
+			return new(big.Rat).SetFloat64(f)
+		}
+		r, _ := new(big.Rat).SetString(fmt.Sprintf("%v", v))
+		return r
+	}
+
+	for _, a := range values {
+		for _, b := range values {
+			ab, err := safecast.Compare(a, b)
+			if err != nil {
+				t.Errorf("Compare(%T(%v), %T(%v)) = %v", a, a, b, b, err)
+				continue
+			}
+			ba, err := safecast.Compare(b, a)
+			if err != nil {
+				t.Errorf("Compare(%T(%v), %T(%v)) = %v", b, b, a, a, err)
+				continue
+			}
+			if ab != -ba {
+				t.Errorf("Compare(%T(%v), %T(%v)) = %d, but reversed = %d", a, a, b, b, ab, ba)
+			}
+			fa, aInf := a.(float64)
+			fb, bInf := b.(float64)
+			if (aInf && math.IsInf(fa, 0)) || (bInf && math.IsInf(fb, 0)) {
+				continue
+			}
+			if want := exact(a).Cmp(exact(b)); ab != want {
+				t.Errorf("Compare(%T(%v), %T(%v)) = %d, want %d", a, a, b, b, ab, want)
+			}
+		}
+	}
+}
+
+// compareCorpus is a set of heterogeneous values used to check the properties of Compare.
+func compareCorpus() []any {
+	i, s, b := 7, "7", true
+	var nilInt *int
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []any{
+		int8(-1), int8(1), 300, uint8(255), uint64(math.MaxUint64), int64(math.MinInt64),
+		float32(3.14), 3.14, -0.5, math.NaN(), math.Inf(1), math.Inf(-1),
+		big.NewInt(5), big.NewRat(1, 3), big.NewFloat(2.5),
+		"10", "5", "-1", "3.14", "abc", "", "true", "false",
+		true, false,
+		[]byte("abc"), []byte("10"), []byte{},
+		ts, ts.Add(time.Hour), "2022-01-01T00:00:00Z",
+		&i, &s, &b, nilInt, &ts,
+		UserID(7), Name("abc"), Flag(true),
+	}
+}
+
+func TestCompareAntisymmetricProperty(t *testing.T) {
+	values := compareCorpus()
+	for _, a := range values {
+		for _, b := range values {
+			ab, errAB := safecast.Compare(a, b)
+			ba, errBA := safecast.Compare(b, a)
+			if (errAB == nil) != (errBA == nil) {
+				t.Errorf("Compare(%T(%v), %T(%v)) error = %v, but reversed error = %v", a, a, b, b, errAB, errBA)
+				continue
+			}
+			if errAB != nil {
+				continue
+			}
+			if ab != -ba {
+				t.Errorf("Compare(%T(%v), %T(%v)) = %d, but reversed = %d", a, a, b, b, ab, ba)
+			}
+		}
+	}
+}
+
+func TestCompareReflexiveProperty(t *testing.T) {
+	for _, v := range compareCorpus() {
+		if r, err := safecast.Compare(v, v); err != nil || r != 0 {
+			t.Errorf("Compare(%T(%v), itself) = %d, %v", v, v, r, err)
+		}
+	}
+}
+
+func TestCompareAntisymmetricQuick(t *testing.T) {
+	generate := func(r *rand.Rand) any {
+		switch r.Intn(8) {
+		case 0:
+			return int8(r.Intn(256) - 128)
+		case 1:
+			return r.Int63() - r.Int63()
+		case 2:
+			return r.Uint64()
+		case 3:
+			return float32(r.NormFloat64() * 1000)
+		case 4:
+			return r.NormFloat64() * 1e20
+		case 5:
+			return strconv.Itoa(r.Intn(2000) - 1000)
+		case 6:
+			return r.Intn(2) == 0
+		default:
+			return []byte(strconv.Itoa(r.Intn(100)))
+		}
+	}
+	property := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		a, b := generate(r), generate(r)
+		ab, errAB := safecast.Compare(a, b)
+		ba, errBA := safecast.Compare(b, a)
+		if (errAB == nil) != (errBA == nil) {
+			t.Logf("Compare(%T(%v), %T(%v)) error = %v, reversed error = %v", a, a, b, b, errAB, errBA)
+			return false
+		}
+		if errAB == nil && ab != -ba {
+			t.Logf("Compare(%T(%v), %T(%v)) = %d, reversed = %d", a, a, b, b, ab, ba)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 5000}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+// Money is an amount in cents.
+type Money struct {
+	Cents int64
+}
+
+// UUID is a struct type without an underlying builtin kind.
+type UUID [16]byte
+
+// registerConverters registers the global converters of the test types, and removes them when the test finishes.
+func registerConverters(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		safecast.UnregisterConverter[Money, int64]()
+		safecast.UnregisterConverter[Money, string]()
+		safecast.UnregisterConverter[string, Money]()
+		safecast.UnregisterConverterFunc(reflect.TypeOf(UUID{}), reflect.TypeOf(""))
+	})
+	safecast.RegisterConverter(func(m Money) (int64, error) {
+		return m.Cents, nil
+	})
+	safecast.RegisterConverter(func(m Money) (string, error) {
+		return fmt.Sprintf("%d.%02d", m.Cents/100, m.Cents%100), nil
+	})
+	safecast.RegisterConverter(func(s string) (Money, error) {
+		units, cents, _ := strings.Cut(s, ".")
+		u, err := strconv.ParseInt(units, 10, 64)
+		if err != nil {
+			return Money{}, err
+		}
+		c, err := strconv.ParseInt(cents, 10, 64)
+		if err != nil {
+			return Money{}, err
+		}
+		return Money{Cents: u*100 + c}, nil
+	})
+	safecast.RegisterConverterFunc(reflect.TypeOf(UUID{}), reflect.TypeOf(""), func(from any) (any, error) {
+		u := from.(UUID)
+		return fmt.Sprintf("%x", u[:]), nil
+	})
+}
+
+func TestRegisterConverter(t *testing.T) {
+	registerConverters(t)
+	var i int64
+	if err := safecast.To(Money{Cents: 1234}, &i); err != nil || i != 1234 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	var s string
+	if err := safecast.From(&Money{Cents: 1234}, &s); err != nil || s != "12.34" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+	var m Money
+	if err := safecast.To("5.06", &m); err != nil || m.Cents != 506 {
+		t.Errorf("To() = %v, %v", m, err)
+	}
+	err := safecast.To("x.06", &m)
+	var castErr *safecast.CastError
+	if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonUnsupported || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("To() = %v, want ReasonUnsupported", err)
+	}
+	// The types without a direct converter are cast through the registered builtin type.
+	var f float64
+	if err := safecast.To(Money{Cents: 1234}, &f); err != nil || f != 1234 {
+		t.Errorf("To() = %v, %v", f, err)
+	}
+	var i8 int8
+	if err := safecast.To(Money{Cents: 1234}, &i8); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+	if err := safecast.To(struct{ Cents int64 }{}, &f); !errors.Is(err, safecast.ErrUnsupportedType) {
+		t.Errorf("To() = %v, want ErrUnsupportedType", err)
+	}
+	if err := safecast.To(UUID{0xab}, &s); err != nil || !strings.HasPrefix(s, "ab00") {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if v, err := safecast.Cast[string](UUID{0x01}); err != nil || !strings.HasPrefix(v, "0100") {
+		t.Errorf("Cast() = %q, %v", v, err)
+	}
+}
+
+func TestRegisterConverterCompare(t *testing.T) {
+	registerConverters(t)
+	tests := []struct {
+		v1   any
+		v2   any
+		want int
+	}{
+		{Money{Cents: 100}, 100, 0},
+		{100, Money{Cents: 100}, 0},
+		{Money{Cents: 100}, "1.00", 0},
+		{Money{Cents: 100}, Money{Cents: 200}, -1},
+		{Money{Cents: 300}, int64(200), 1},
+	}
+	for _, test := range tests {
+		cmp, err := safecast.Compare(test.v1, test.v2)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if cmp != test.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", test.v1, test.v2, cmp, test.want)
+		}
+	}
+	if !safecast.Equal(Money{Cents: 42}, 42) {
+		t.Errorf("Equal() = false")
+	}
+	if safecast.Equal(Money{Cents: 42}, 43) {
+		t.Errorf("Equal() = true")
+	}
+}
+
+func TestWithConverter(t *testing.T) {
+	type Celsius struct{ Degree float64 }
+
+	c := safecast.NewCaster(safecast.WithConverter(func(c Celsius) (float64, error) {
+		return c.Degree, nil
+	}))
+	var f float64
+	if err := c.To(Celsius{Degree: 36.5}, &f); err != nil || f != 36.5 {
+		t.Errorf("To() = %v, %v", f, err)
+	}
+	if err := safecast.To(Celsius{Degree: 36.5}, &f); err == nil {
+		t.Errorf("To() should fail without the caster converter")
+	}
+	if !c.Equal(Celsius{Degree: 1.5}, "1.5") {
+		t.Errorf("Equal() = false")
+	}
+
+	// The caster converter takes precedence over the global converter.
+	c = safecast.NewCaster(safecast.WithConverter(func(m Money) (int64, error) {
+		return m.Cents / 100, nil
+	}))
+	var i int64
+	if err := c.To(Money{Cents: 1234}, &i); err != nil || i != 12 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+}
+
+func TestRegisterConverterConcurrency(t *testing.T) {
+	type Counter int
+	defer safecast.UnregisterConverter[Counter, string]()
+
+	var wg sync.WaitGroup
+	for n := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			safecast.RegisterConverter(func(c Counter) (string, error) {
+				return strconv.Itoa(int(c) + n - n), nil
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			var s string
+			_ = safecast.To(Counter(n), &s)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestUnregisterConverter(t *testing.T) {
+	type Percent int
+
+	safecast.RegisterConverter(func(p Percent) (string, error) {
+		return strconv.Itoa(int(p)) + "%", nil
+	})
+	var s string
+	if err := safecast.To(Percent(42), &s); err != nil || s != "42%" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	safecast.UnregisterConverter[Percent, string]()
+	if err := safecast.To(Percent(42), &s); err != nil || s != "42" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	// Unregistering a converter which is not registered does nothing.
+	safecast.UnregisterConverter[Percent, string]()
+}
+
+func TestRegisterConverterErrorReason(t *testing.T) {
+	type Grade struct{ Score int }
+
+	safecast.RegisterConverter(func(g Grade) (int8, error) {
+		var v int8
+		return v, safecast.To(g.Score, &v)
+	})
+	defer safecast.UnregisterConverter[Grade, int8]()
+
+	var i8 int8
+	err := safecast.To(Grade{Score: 300}, &i8)
+	var castErr *safecast.CastError
+	if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonOverflow {
+		t.Errorf("To() = %v, want ReasonOverflow", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+type UserID int64
+type Status uint8
+type Ratio float32
+type Name string
+type Flag bool
+type Payload []byte
+
+type Color int
+
+func (c Color) String() string {
+	return fmt.Sprintf("color%d", int(c))
+}
+
+func TestDefinedTypeTo(t *testing.T) {
+	t.Run("defined destination", func(t *testing.T) {
+		var id UserID
+		if err := safecast.To("42", &id); err != nil || id != 42 {
+			t.Errorf("To() = %v, %v", id, err)
+		}
+		var status Status
+		if err := safecast.To(int64(255), &status); err != nil || status != 255 {
+			t.Errorf("To() = %v, %v", status, err)
+		}
+		if err := safecast.To(256, &status); err == nil {
+			t.Errorf("To() expected overflow error")
+		}
+		if err := safecast.To(-1, &status); err == nil {
+			t.Errorf("To() expected underflow error")
+		}
+		var ratio Ratio
+		if err := safecast.To("0.5", &ratio); err != nil || ratio != 0.5 {
+			t.Errorf("To() = %v, %v", ratio, err)
+		}
+		var name Name
+		if err := safecast.To(123, &name); err != nil || name != "123" {
+			t.Errorf("To() = %v, %v", name, err)
+		}
+		var flag Flag
+		if err := safecast.To("true", &flag); err != nil || !flag {
+			t.Errorf("To() = %v, %v", flag, err)
+		}
+		var payload Payload
+		if err := safecast.To("abc", &payload); err != nil || string(payload) != "abc" {
+			t.Errorf("To() = %v, %v", payload, err)
+		}
+	})
+
+	t.Run("defined source", func(t *testing.T) {
+		var i8 int8
+		if err := safecast.To(Status(100), &i8); err != nil || i8 != 100 {
+			t.Errorf("To() = %v, %v", i8, err)
+		}
+		if err := safecast.To(Status(200), &i8); err == nil {
+			t.Errorf("To() expected overflow error")
+		}
+		id := UserID(7)
+		var s string
+		if err := safecast.To(&id, &s); err != nil || s != "7" {
+			t.Errorf("To() = %v, %v", s, err)
+		}
+		var u uint
+		if err := safecast.To(Name("12"), &u); err != nil || u != 12 {
+			t.Errorf("To() = %v, %v", u, err)
+		}
+	})
+
+	t.Run("defined source and destination", func(t *testing.T) {
+		var status Status
+		if err := safecast.To(UserID(3), &status); err != nil || status != 3 {
+			t.Errorf("To() = %v, %v", status, err)
+		}
+		if err := safecast.To(UserID(-3), &status); err == nil {
+			t.Errorf("To() expected underflow error")
+		}
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		type Point struct{ X, Y int }
+		var p Point
+		if err := safecast.To(1, &p); err == nil {
+			t.Errorf("To() expected error")
+		}
+	})
+}
+
+func TestDefinedTypeFrom(t *testing.T) {
+	var i64 int64
+	if err := safecast.From(UserID(42), &i64); err != nil || i64 != 42 {
+		t.Errorf("From() = %v, %v", i64, err)
+	}
+	var status Status
+	if err := safecast.From(int16(12), &status); err != nil || status != 12 {
+		t.Errorf("From() = %v, %v", status, err)
+	}
+	if err := safecast.From(UserID(1000), &status); err == nil {
+		t.Errorf("From() expected overflow error")
+	}
+	var name Name
+	if err := safecast.From(Flag(true), &name); err != nil || name != "true" {
+		t.Errorf("From() = %v, %v", name, err)
+	}
+}
+
+func TestDefinedTypeCast(t *testing.T) {
+	if v, err := safecast.Cast[Status]("200"); err != nil || v != 200 {
+		t.Errorf("Cast[Status]() = %v, %v", v, err)
+	}
+	if _, err := safecast.Cast[Status]("300"); err == nil {
+		t.Errorf("Cast[Status]() expected overflow error")
+	}
+	if v, err := safecast.Cast[Name](UserID(1)); err != nil || v != "1" {
+		t.Errorf("Cast[Name]() = %v, %v", v, err)
+	}
+}
+
+func TestDefinedTypeStringer(t *testing.T) {
+	var s string
+	if err := safecast.ToString(Color(3), &s); err != nil || s != "color3" {
+		t.Errorf("ToString() = %q, %v", s, err)
+	}
+	if err := safecast.To(Color(3), &s); err != nil || s != "color3" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if err := safecast.From(Color(3), &s); err != nil || s != "color3" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+	c := Color(4)
+	if err := safecast.To(&c, &s); err != nil || s != "color4" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if v, err := safecast.Cast[Name](Color(5)); err != nil || v != "color5" {
+		t.Errorf("Cast[Name]() = %q, %v", v, err)
+	}
+	var i int8
+	if err := safecast.To(Color(3), &i); err != nil || i != 3 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+}
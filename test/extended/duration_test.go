@@ -0,0 +1,216 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+// Timeout is a defined type over time.Duration.
+type Timeout time.Duration
+
+func TestToDuration(t *testing.T) {
+	tests := []struct {
+		from any
+		unit []time.Duration
+		want time.Duration
+	}{
+		{"1h30m", nil, 90 * time.Minute},
+		{"-1.5s", nil, -1500 * time.Millisecond},
+		{"90", nil, 90},
+		{"90", []time.Duration{time.Second}, 90 * time.Second},
+		{"1.5", []time.Duration{time.Second}, 1500 * time.Millisecond},
+		{"15e-1", []time.Duration{time.Second}, 1500 * time.Millisecond},
+		{[]byte("2ms"), nil, 2 * time.Millisecond},
+		{int8(-3), []time.Duration{time.Minute}, -3 * time.Minute},
+		{uint16(500), []time.Duration{time.Millisecond}, 500 * time.Millisecond},
+		{0.3, []time.Duration{time.Second}, 300 * time.Millisecond},
+		{float32(0.5), []time.Duration{time.Second}, 500 * time.Millisecond},
+		{1.9, nil, 1},
+		{int64(math.MaxInt64), nil, time.Duration(math.MaxInt64)},
+		{big.NewRat(1, 4), []time.Duration{time.Second}, 250 * time.Millisecond},
+		{2 * time.Second, nil, 2 * time.Second},
+	}
+	for _, tt := range tests {
+		var d time.Duration
+		if err := safecast.ToDuration(tt.from, &d, tt.unit...); err != nil {
+			t.Errorf("ToDuration(%v, %v) = %v", tt.from, tt.unit, err)
+			continue
+		}
+		if d != tt.want {
+			t.Errorf("ToDuration(%v, %v) = %v, want %v", tt.from, tt.unit, d, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		from any
+		unit []time.Duration
+		err  error
+	}{
+		{int64(math.MaxInt64), []time.Duration{time.Second}, safecast.ErrOverflow},
+		{int64(math.MinInt64 / 2), []time.Duration{time.Millisecond}, safecast.ErrUnderflow},
+		{uint64(math.MaxUint64), nil, safecast.ErrOverflow},
+		{1e300, nil, safecast.ErrOverflow},
+		{-1e10, []time.Duration{time.Hour}, safecast.ErrUnderflow},
+		{math.NaN(), nil, safecast.ErrNaN},
+		{math.Inf(1), nil, safecast.ErrInfinity},
+		{"10 parsecs", nil, safecast.ErrSyntax},
+		{"1/3", []time.Duration{time.Second}, safecast.ErrSyntax},
+		{"0x10", nil, safecast.ErrSyntax},
+		{"3000000h", nil, safecast.ErrSyntax},
+		{true, nil, safecast.ErrUnsupportedType},
+	}
+	for _, tt := range errTests {
+		var d time.Duration
+		if err := safecast.ToDuration(tt.from, &d, tt.unit...); !errors.Is(err, tt.err) {
+			t.Errorf("ToDuration(%v, %v) = %v, want %v", tt.from, tt.unit, err, tt.err)
+		}
+	}
+}
+
+func TestFromDuration(t *testing.T) {
+	d := 1500 * time.Millisecond
+
+	var s string
+	if err := safecast.FromDuration(d, &s); err != nil || s != "1.5s" {
+		t.Errorf("FromDuration() = %q, %v", s, err)
+	}
+	var i64 int64
+	if err := safecast.FromDuration(d, &i64); err != nil || i64 != 1500000000 {
+		t.Errorf("FromDuration() = %v, %v", i64, err)
+	}
+	if err := safecast.FromDuration(d, &i64, time.Second); err != nil || i64 != 1 {
+		t.Errorf("FromDuration() = %v, %v", i64, err)
+	}
+	var f64 float64
+	if err := safecast.FromDuration(d, &f64, time.Second); err != nil || f64 != 1.5 {
+		t.Errorf("FromDuration() = %v, %v", f64, err)
+	}
+	var i8 int8
+	if err := safecast.FromDuration(d, &i8); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("FromDuration() = %v, want ErrOverflow", err)
+	}
+	var u8 uint8
+	if err := safecast.FromDuration(-time.Second, &u8, time.Second); !errors.Is(err, safecast.ErrUnderflow) {
+		t.Errorf("FromDuration() = %v, want ErrUnderflow", err)
+	}
+	var r big.Rat
+	if err := safecast.FromDuration(d, &r, time.Second); err != nil || r.Cmp(big.NewRat(3, 2)) != 0 {
+		t.Errorf("FromDuration() = %v, %v", &r, err)
+	}
+}
+
+func TestDurationDefinedType(t *testing.T) {
+	var id UserID
+	if err := safecast.To(2*time.Second, &id); err != nil || id != 2000000000 {
+		t.Errorf("To() = %v, %v", id, err)
+	}
+	if err := safecast.From(2*time.Second, &id); err != nil || id != 2000000000 {
+		t.Errorf("From() = %v, %v", id, err)
+	}
+	if err := safecast.FromDuration(1500*time.Millisecond, &id, time.Second); err != nil || id != 1 {
+		t.Errorf("FromDuration() = %v, %v", id, err)
+	}
+	var r Ratio
+	if err := safecast.FromDuration(1500*time.Millisecond, &r, time.Second); err != nil || r != 1.5 {
+		t.Errorf("FromDuration() = %v, %v", r, err)
+	}
+	var n Name
+	if err := safecast.To(90*time.Minute, &n); err != nil || n != "1h30m0s" {
+		t.Errorf("To() = %q, %v", n, err)
+	}
+	var st Status
+	if err := safecast.To(time.Second, &st); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+
+	// A defined type over time.Duration accepts a duration string.
+	var to Timeout
+	if err := safecast.To("1h", &to); err != nil || to != Timeout(time.Hour) {
+		t.Errorf("To() = %v, %v", to, err)
+	}
+	if err := safecast.From([]byte("1m30s"), &to); err != nil || to != Timeout(90*time.Second) {
+		t.Errorf("From() = %v, %v", to, err)
+	}
+	if err := safecast.To("42", &to); err != nil || to != 42 {
+		t.Errorf("To() = %v, %v", to, err)
+	}
+	if v, err := safecast.Cast[Timeout]("250ms"); err != nil || v != Timeout(250*time.Millisecond) {
+		t.Errorf("Cast() = %v, %v", v, err)
+	}
+	if err := safecast.To("10 parsecs", &to); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("To() = %v, want ErrSyntax", err)
+	}
+	if err := safecast.To("1h", &st); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("To() = %v, want ErrSyntax", err)
+	}
+}
+
+func TestDurationToFrom(t *testing.T) {
+	var d time.Duration
+	if err := safecast.To("1h30m", &d); err != nil || d != 90*time.Minute {
+		t.Errorf("To() = %v, %v", d, err)
+	}
+	if err := safecast.From("250ms", &d); err != nil || d != 250*time.Millisecond {
+		t.Errorf("From() = %v, %v", d, err)
+	}
+	if err := safecast.To(int64(5), &d); err != nil || d != 5 {
+		t.Errorf("To() = %v, %v", d, err)
+	}
+	var s string
+	if err := safecast.To(2*time.Second, &s); err != nil || s != "2s" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if err := safecast.From(&d, &s); err != nil || s != "5ns" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+	var i int
+	if err := safecast.To(time.Microsecond, &i); err != nil || i != 1000 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	if v, err := safecast.Cast[time.Duration]("3m"); err != nil || v != 3*time.Minute {
+		t.Errorf("Cast() = %v, %v", v, err)
+	}
+}
+
+func TestDurationCompare(t *testing.T) {
+	tests := []struct {
+		v1, v2 any
+		want   int
+	}{
+		{2 * time.Second, "2s", 0},
+		{"2s", 2 * time.Second, 0},
+		{time.Second, "1500ms", -1},
+		{"1m", time.Second, 1},
+		{time.Second, int64(1000000000), 0},
+		{time.Second, 1.5e9, -1},
+		{time.Minute, time.Hour, -1},
+	}
+	for _, tt := range tests {
+		r, err := safecast.Compare(tt.v1, tt.v2)
+		if err != nil || r != tt.want {
+			t.Errorf("Compare(%v, %v) = %v, %v, want %v", tt.v1, tt.v2, r, err, tt.want)
+		}
+	}
+	if !safecast.Equal(90*time.Second, "1m30s") {
+		t.Errorf("Equal() = false")
+	}
+}
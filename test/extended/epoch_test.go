@@ -0,0 +1,235 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestToUnixTime(t *testing.T) {
+	tests := []struct {
+		from any
+		unit []time.Duration
+		want time.Time
+	}{
+		{int64(1700000000), nil, time.Unix(1700000000, 0)},
+		{int64(1700000000123), nil, time.UnixMilli(1700000000123)},
+		{int64(1700000000123456), nil, time.UnixMicro(1700000000123456)},
+		{int64(1700000000123456789), nil, time.Unix(1700000000, 123456789)},
+		{int64(1700000000), []time.Duration{time.Millisecond}, time.UnixMilli(1700000000)},
+		{uint32(1700000000), nil, time.Unix(1700000000, 0)},
+		{int8(-1), nil, time.Unix(-1, 0)},
+		{1700000000.123, nil, time.Unix(1700000000, 123000000)},
+		{-1.5, nil, time.Unix(-2, 500000000)},
+		{"1700000000.123", nil, time.Unix(1700000000, 123000000)},
+		{[]byte("1700000000123"), nil, time.UnixMilli(1700000000123)},
+		{"+1700000000", []time.Duration{time.Second}, time.Unix(1700000000, 0)},
+		{"1700000000.5", []time.Duration{time.Millisecond}, time.Unix(1700000, 500000)},
+		{big.NewInt(1700000000), nil, time.Unix(1700000000, 0)},
+		{big.NewRat(3, 2), nil, time.Unix(1, 500000000)},
+		{int64(253402300799), []time.Duration{time.Second}, time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{int64(-62135596800), []time.Duration{time.Second}, time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		var v time.Time
+		if err := safecast.ToUnixTime(tt.from, &v, tt.unit...); err != nil {
+			t.Errorf("ToUnixTime(%v, %v) = %v", tt.from, tt.unit, err)
+			continue
+		}
+		if !v.Equal(tt.want) || v.Location() != time.UTC {
+			t.Errorf("ToUnixTime(%v, %v) = %v, want %v", tt.from, tt.unit, v, tt.want.UTC())
+		}
+	}
+
+	errTests := []struct {
+		from any
+		unit []time.Duration
+		err  error
+	}{
+		{int64(math.MaxInt64), []time.Duration{time.Second}, safecast.ErrOverflow},
+		{int64(math.MinInt64), []time.Duration{time.Second}, safecast.ErrUnderflow},
+		{int64(253402300800), []time.Duration{time.Second}, safecast.ErrOverflow},
+		{int64(-62135596801), []time.Duration{time.Second}, safecast.ErrUnderflow},
+		{uint64(math.MaxUint64), []time.Duration{time.Millisecond}, safecast.ErrOverflow},
+		{1e300, nil, safecast.ErrOverflow},
+		{-1e300, nil, safecast.ErrUnderflow},
+		{math.NaN(), nil, safecast.ErrNaN},
+		{math.Inf(-1), nil, safecast.ErrInfinity},
+		{"1e9", nil, safecast.ErrSyntax},
+		{"1700000000s", nil, safecast.ErrSyntax},
+		{".", nil, safecast.ErrSyntax},
+		{true, nil, safecast.ErrUnsupportedType},
+		{int64(1), []time.Duration{0}, safecast.ErrUnsupportedType},
+	}
+	for _, tt := range errTests {
+		var v time.Time
+		if err := safecast.ToUnixTime(tt.from, &v, tt.unit...); !errors.Is(err, tt.err) {
+			t.Errorf("ToUnixTime(%v, %v) = %v, want %v", tt.from, tt.unit, err, tt.err)
+		}
+	}
+}
+
+func TestUnixTimeToTime(t *testing.T) {
+	want := time.Unix(1700000000, 0)
+
+	var v time.Time
+	if err := safecast.ToTime(int64(1700000000), &v); err != nil || !v.Equal(want) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.ToWith("1700000000", &v, safecast.WithTimeUnit(time.Second)); err != nil || !v.Equal(want) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	// A numeric string is not cast as a Unix epoch number without the unit.
+	if err := safecast.ToTime("1700000000", &v, time.RFC3339); err == nil {
+		t.Errorf("ToTime() = %v, want error", v)
+	}
+	// A numeric string which is not a time string returns an error naming the missing unit.
+	for _, s := range []string{"1700000000.123", "1700000000"} {
+		err := safecast.ToTime(s, &v)
+		if !errors.Is(err, safecast.ErrSyntax) || !strings.Contains(err.Error(), "Unix time unit") {
+			t.Errorf("ToTime(%q) = %v, want the missing unit error", s, err)
+		}
+	}
+	if err := safecast.ToTime("20240102", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.ToWith("1700000000.123", &v, safecast.WithTimeUnit(time.Second)); err != nil || !v.Equal(time.Unix(1700000000, 123000000)) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	if err := safecast.To(uint64(1700000000000), &v); err != nil || !v.Equal(want) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	if err := safecast.ToTime(int64(math.MaxInt64), &v); err != nil || v.Year() != 2262 {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.ToWith("99999999999999999999999", &v, safecast.WithTimeUnit(time.Second)); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+
+	loc := time.FixedZone("JST", 9*60*60)
+	if err := safecast.ToWith(1700000000, &v, safecast.WithLocation(loc)); err != nil || !v.Equal(want) || v.Location() != loc {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+
+	if r, err := safecast.Compare(want, int64(1700000000)); err != nil || r != 0 {
+		t.Errorf("Compare() = %v, %v", r, err)
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	tm := time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)
+
+	var i64 int64
+	if err := safecast.FromTime(tm, &i64); err != nil || i64 != 1700000000 {
+		t.Errorf("FromTime() = %v, %v", i64, err)
+	}
+	if err := safecast.FromTime(tm, &i64, safecast.WithTimeUnit(time.Millisecond)); err != nil || i64 != 1700000000123 {
+		t.Errorf("FromTime() = %v, %v", i64, err)
+	}
+	if err := safecast.FromTime(tm, &i64, safecast.WithTimeUnit(time.Nanosecond)); err != nil || i64 != 1700000000123456789 {
+		t.Errorf("FromTime() = %v, %v", i64, err)
+	}
+	if err := safecast.FromTime(time.Unix(-1, 500000000), &i64); err != nil || i64 != -1 {
+		t.Errorf("FromTime() = %v, %v", i64, err)
+	}
+	var u32 uint32
+	if err := safecast.FromTime(tm, &u32); err != nil || u32 != 1700000000 {
+		t.Errorf("FromTime() = %v, %v", u32, err)
+	}
+	var f64 float64
+	if err := safecast.FromTime(time.Unix(1700000000, 500000000), &f64); err != nil || f64 != 1700000000.5 {
+		t.Errorf("FromTime() = %v, %v", f64, err)
+	}
+	var r big.Rat
+	if err := safecast.FromTime(tm, &r, safecast.WithTimeUnit(time.Millisecond)); err != nil || r.Cmp(big.NewRat(1700000000123456789, 1000000)) != 0 {
+		t.Errorf("FromTime() = %v, %v", &r, err)
+	}
+	var s string
+	if err := safecast.FromTime(tm, &s, safecast.WithTimeLayouts(safecast.DateTime)); err != nil || s != "2023-11-14 22:13:20" {
+		t.Errorf("FromTime() = %q, %v", s, err)
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	if err := safecast.FromTime(tm, &s, safecast.WithTimeLayouts(time.RFC3339), safecast.WithLocation(jst)); err != nil || s != "2023-11-15T07:13:20+09:00" {
+		t.Errorf("FromTime() = %q, %v", s, err)
+	}
+	var b []byte
+	if err := safecast.FromTime(tm, &b, safecast.WithTimeLayouts(safecast.ISO8601DateLayout)); err != nil || string(b) != "2023-11-14" {
+		t.Errorf("FromTime() = %q, %v", b, err)
+	}
+
+	errTests := []struct {
+		from time.Time
+		to   any
+		opts []safecast.Option
+		err  error
+	}{
+		{tm, new(int32), []safecast.Option{safecast.WithTimeUnit(time.Nanosecond)}, safecast.ErrOverflow},
+		{tm, new(int32), []safecast.Option{safecast.WithTimeUnit(time.Millisecond)}, safecast.ErrOverflow},
+		{time.Unix(-1, 0), new(uint64), nil, safecast.ErrUnderflow},
+		{time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), new(int64), []safecast.Option{safecast.WithTimeUnit(time.Nanosecond)}, safecast.ErrOverflow},
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), new(int64), []safecast.Option{safecast.WithTimeUnit(time.Nanosecond)}, safecast.ErrUnderflow},
+		{tm, new(bool), nil, safecast.ErrUnsupportedType},
+		{tm, new(time.Duration), nil, safecast.ErrUnsupportedType},
+		{tm, new(int64), []safecast.Option{safecast.WithTimeUnit(-time.Second)}, safecast.ErrUnsupportedType},
+	}
+	for _, tt := range errTests {
+		if err := safecast.FromTime(tt.from, tt.to, tt.opts...); !errors.Is(err, tt.err) {
+			t.Errorf("FromTime(%v, %T) = %v, want %v", tt.from, tt.to, err, tt.err)
+		}
+	}
+}
+
+func TestTimeToFrom(t *testing.T) {
+	tm := time.Unix(1700000000, 0)
+
+	var i64 int64
+	if err := safecast.From(tm, &i64); err != nil || i64 != 1700000000 {
+		t.Errorf("From() = %v, %v", i64, err)
+	}
+	if err := safecast.ToWith(&tm, &i64, safecast.WithTimeUnit(time.Millisecond)); err != nil || i64 != 1700000000000 {
+		t.Errorf("To() = %v, %v", i64, err)
+	}
+	type Epoch int64
+	var e Epoch
+	if err := safecast.From(tm, &e); err != nil || e != 1700000000 {
+		t.Errorf("From() = %v, %v", e, err)
+	}
+	var i16 int16
+	if err := safecast.To(tm, &i16); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+	var s string
+	if err := safecast.From(tm.UTC(), &s); err != nil || s != "2023-11-14 22:13:20 +0000 UTC" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+	if v, err := safecast.Cast[int64](tm, safecast.WithTimeUnit(time.Microsecond)); err != nil || v != 1700000000000000 {
+		t.Errorf("Cast() = %v, %v", v, err)
+	}
+
+	var v time.Time
+	if err := safecast.ToWith(int64(1700000000), &v, safecast.WithTimeUnit(time.Millisecond)); err != nil || !v.Equal(time.UnixMilli(1700000000)) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	if err := safecast.ToWith(i64, &v, safecast.WithTimeUnit(time.Millisecond)); err != nil || !v.Equal(tm) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+}
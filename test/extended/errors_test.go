@@ -0,0 +1,226 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestCastError(t *testing.T) {
+	tests := []struct {
+		name     string
+		cast     func() error
+		from     any
+		toType   reflect.Type
+		reason   safecast.Reason
+		sentinel error
+	}{
+		{
+			"overflow",
+			func() error { var to int8; return safecast.ToInt8(300, &to) },
+			300, reflect.TypeOf(int8(0)), safecast.ReasonOverflow, nil,
+		},
+		{
+			"underflow",
+			func() error { var to uint16; return safecast.FromInt64(-1, &to) },
+			int64(-1), reflect.TypeOf(uint16(0)), safecast.ReasonUnderflow, nil,
+		},
+		{
+			"syntax",
+			func() error { var to int32; return safecast.ToInt32("abc", &to) },
+			"abc", reflect.TypeOf(int32(0)), safecast.ReasonSyntax, nil,
+		},
+		{
+			"unsupported",
+			func() error { var to int64; return safecast.ToInt64([]int{1}, &to) },
+			[]int{1}, reflect.TypeOf(int64(0)), safecast.ReasonUnsupported, nil,
+		},
+		{
+			"nil",
+			func() error { var to uint; return safecast.ToUint(nil, &to) },
+			nil, reflect.TypeOf(uint(0)), safecast.ReasonNil, safecast.ErrNil,
+		},
+		{
+			"fractional",
+			func() error {
+				var to int
+				return safecast.ToWith(1.5, &to, safecast.WithRoundingMode(safecast.RoundExact))
+			},
+			1.5, reflect.TypeOf(int(0)), safecast.ReasonFractional, safecast.ErrFractional,
+		},
+		{
+			"NaN",
+			func() error { var to int; return safecast.FromFloat64(math.NaN(), &to) },
+			nil, reflect.TypeOf(int(0)), safecast.ReasonNaN, safecast.ErrNaN,
+		},
+		{
+			"infinity",
+			func() error { var to uint; return safecast.FromFloat64(math.Inf(1), &to) },
+			math.Inf(1), reflect.TypeOf(uint(0)), safecast.ReasonInfinity, safecast.ErrInfinity,
+		},
+		{
+			"precision loss",
+			func() error {
+				var to float64
+				return safecast.ToWith(int64(1<<53+1), &to, safecast.WithPrecisionCheck())
+			},
+			int64(1<<53 + 1), reflect.TypeOf(float64(0)), safecast.ReasonPrecisionLoss, safecast.ErrPrecisionLoss,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cast()
+			if !errors.Is(err, safecast.ErrCast) {
+				t.Fatalf("%v is not %v", err, safecast.ErrCast)
+			}
+			var castErr *safecast.CastError
+			if !errors.As(err, &castErr) {
+				t.Fatalf("%v is not *CastError", err)
+			}
+			if castErr.Reason != test.reason {
+				t.Errorf("Reason = %v, want %v", castErr.Reason, test.reason)
+			}
+			if castErr.ToType != test.toType {
+				t.Errorf("ToType = %v, want %v", castErr.ToType, test.toType)
+			}
+			if test.from != nil && !reflect.DeepEqual(castErr.From, test.from) {
+				t.Errorf("From = %v, want %v", castErr.From, test.from)
+			}
+			if test.from != nil && castErr.FromType != reflect.TypeOf(test.from) {
+				t.Errorf("FromType = %v, want %v", castErr.FromType, reflect.TypeOf(test.from))
+			}
+			if test.sentinel != nil && !errors.Is(err, test.sentinel) {
+				t.Errorf("%v is not %v", err, test.sentinel)
+			}
+		})
+	}
+}
+
+func TestCastErrorNumError(t *testing.T) {
+	var to int8
+	err := safecast.FromString("1000", &to)
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Fatalf("%v does not wrap *strconv.NumError", err)
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("%v is not %v", err, strconv.ErrRange)
+	}
+	var castErr *safecast.CastError
+	if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonOverflow {
+		t.Errorf("%v is not an overflow *CastError", err)
+	}
+
+	err = safecast.FromString("-1000", &to)
+	if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonUnderflow {
+		t.Errorf("%v is not an underflow *CastError", err)
+	}
+
+	err = safecast.FromString("abc", &to)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("%v is not %v", err, strconv.ErrSyntax)
+	}
+	if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonSyntax {
+		t.Errorf("%v is not a syntax *CastError", err)
+	}
+}
+
+func TestCastErrorNumErrorTo(t *testing.T) {
+	syntaxTests := []struct {
+		name string
+		cast func() error
+	}{
+		{"ToInt8", func() error { var v int8; return safecast.ToInt8("abc", &v) }},
+		{"ToInt16", func() error { var v int16; return safecast.ToInt16("abc", &v) }},
+		{"ToInt32", func() error { var v int32; return safecast.ToInt32("abc", &v) }},
+		{"ToInt64", func() error { var v int64; return safecast.ToInt64("abc", &v) }},
+		{"ToInt", func() error { var v int; return safecast.ToInt("abc", &v) }},
+		{"ToUint8", func() error { var v uint8; return safecast.ToUint8("abc", &v) }},
+		{"ToUint16", func() error { var v uint16; return safecast.ToUint16("abc", &v) }},
+		{"ToUint32", func() error { var v uint32; return safecast.ToUint32("abc", &v) }},
+		{"ToUint64", func() error { var v uint64; return safecast.ToUint64("abc", &v) }},
+		{"ToUint", func() error { var v uint; return safecast.ToUint("abc", &v) }},
+		{"ToFloat32", func() error { var v float32; return safecast.ToFloat32("abc", &v) }},
+		{"ToFloat64", func() error { var v float64; return safecast.ToFloat64("abc", &v) }},
+	}
+	for _, tt := range syntaxTests {
+		err := tt.cast()
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("%s() = %v, does not wrap *strconv.NumError", tt.name, err)
+		}
+		var castErr *safecast.CastError
+		if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonSyntax {
+			t.Errorf("%s() = %v, is not a syntax *CastError", tt.name, err)
+		}
+	}
+
+	rangeTests := []struct {
+		name   string
+		cast   func() error
+		reason safecast.Reason
+	}{
+		{"ToFloat32", func() error { var v float32; return safecast.ToFloat32("1e300", &v) }, safecast.ReasonOverflow},
+		{"ToFloat32", func() error { var v float32; return safecast.ToFloat32("-1e300", &v) }, safecast.ReasonUnderflow},
+		{"ToFloat64", func() error { var v float64; return safecast.ToFloat64("1e400", &v) }, safecast.ReasonOverflow},
+		{"ToInt64", func() error { var v int64; return safecast.ToInt64("1e400", &v) }, safecast.ReasonOverflow},
+		{"ToUint8", func() error { var v uint8; return safecast.ToUint8("-1e400", &v) }, safecast.ReasonUnderflow},
+	}
+	for _, tt := range rangeTests {
+		err := tt.cast()
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%s() = %v, does not wrap *strconv.NumError", tt.name, err)
+		}
+		var castErr *safecast.CastError
+		if !errors.As(err, &castErr) || castErr.Reason != tt.reason {
+			t.Errorf("%s() = %v, want %v", tt.name, err, tt.reason)
+		}
+	}
+
+	var f32 float32
+	clamped, err := safecast.Saturate("1e300", &f32)
+	if err != nil || !clamped || f32 != math.MaxFloat32 {
+		t.Errorf("Saturate() = %v, %v, %v", f32, clamped, err)
+	}
+}
+
+func TestCastErrorTimeParse(t *testing.T) {
+	var to time.Time
+	err := safecast.ToTime("invalid", &to)
+	if !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("%v is not %v", err, safecast.ErrCast)
+	}
+	var parseErr *time.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("%v does not wrap *time.ParseError", err)
+	}
+}
+
+func TestCastErrorMessage(t *testing.T) {
+	var to int8
+	err := safecast.ToInt8(128, &to)
+	if err.Error() != "cast error : out of range 128 > *int8" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
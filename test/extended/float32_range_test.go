@@ -0,0 +1,127 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestFloat32Range(t *testing.T) {
+	tests := []struct {
+		from    float64
+		want    float32
+		wantErr bool
+	}{
+		{1e300, 0, true},
+		{-1e300, 0, true},
+		{math.MaxFloat64, 0, true},
+		{math.MaxFloat32, math.MaxFloat32, false},
+		{-math.MaxFloat32, -math.MaxFloat32, false},
+		{math.Nextafter(math.MaxFloat32, math.Inf(1)), math.MaxFloat32, false}, // rounded to MaxFloat32
+		{math.Inf(1), float32(math.Inf(1)), false},
+		{math.Inf(-1), float32(math.Inf(-1)), false},
+		{1e-300, 0, false}, // underflow is not an error by default
+		{3.5, 3.5, false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v", test.from), func(t *testing.T) {
+			var to float32
+			for _, err := range []error{
+				safecast.FromFloat64(test.from, &to),
+				safecast.ToFloat32(test.from, &to),
+				safecast.To(test.from, &to),
+			} {
+				if test.wantErr {
+					if err == nil {
+						t.Errorf("%v => %v, expected error", test.from, to)
+					}
+					continue
+				}
+				if err != nil {
+					t.Error(err)
+					continue
+				}
+				if to != test.want {
+					t.Errorf("%v => %v, want %v", test.from, to, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFloat32RangeOptions(t *testing.T) {
+	t.Run("IEEE overflow", func(t *testing.T) {
+		var to float32
+		if err := safecast.ToWith(1e300, &to, safecast.WithIEEEOverflow()); err != nil || !math.IsInf(float64(to), 1) {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+		if err := safecast.ToWith(-1e300, &to, safecast.WithIEEEOverflow()); err != nil || !math.IsInf(float64(to), -1) {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+		if err := safecast.ToWith(1.5, &to, safecast.WithIEEEOverflow()); err != nil || to != 1.5 {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+	})
+
+	t.Run("underflow error", func(t *testing.T) {
+		var to float32
+		if err := safecast.ToWith(1e-300, &to, safecast.WithFloatUnderflowError()); err == nil {
+			t.Errorf("To() = %v, expected underflow error", to)
+		}
+		if err := safecast.ToWith(-1e-300, &to, safecast.WithFloatUnderflowError()); err == nil {
+			t.Errorf("To() = %v, expected underflow error", to)
+		}
+		if err := safecast.ToWith(float64(math.SmallestNonzeroFloat32), &to, safecast.WithFloatUnderflowError()); err != nil || to != math.SmallestNonzeroFloat32 {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+		if err := safecast.ToWith(0.0, &to, safecast.WithFloatUnderflowError()); err != nil || to != 0 {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+		for _, from := range []any{"1e-50", []byte("-1e-50"), json.Number("1e-50"), "1e-400"} {
+			if err := safecast.ToWith(from, &to, safecast.WithFloatUnderflowError()); !errors.Is(err, safecast.ErrFloatUnderflow) {
+				t.Errorf("To(%v) = %v, %v, want ErrFloatUnderflow", from, to, err)
+			}
+			if err := safecast.FromWith(from, &to, safecast.WithFloatUnderflowError()); !errors.Is(err, safecast.ErrFloatUnderflow) {
+				t.Errorf("From(%v) = %v, %v, want ErrFloatUnderflow", from, to, err)
+			}
+		}
+		if err := safecast.ToWith("0.0", &to, safecast.WithFloatUnderflowError()); err != nil || to != 0 {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+	})
+
+	t.Run("underflow and overflow reasons", func(t *testing.T) {
+		var to float32
+		var castErr *safecast.CastError
+		err := safecast.ToWith(1e-50, &to, safecast.WithFloatUnderflowError())
+		if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonFloatUnderflow || errors.Is(err, safecast.ErrUnderflow) {
+			t.Errorf("To(1e-50) = %v, want ReasonFloatUnderflow", err)
+		}
+		err = safecast.ToWith(-1e300, &to, safecast.WithFloatUnderflowError())
+		if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonUnderflow || errors.Is(err, safecast.ErrFloatUnderflow) {
+			t.Errorf("To(-1e300) = %v, want ReasonUnderflow", err)
+		}
+		if err := safecast.ToWith("1e300", &to, safecast.WithIEEEOverflow()); err != nil || !math.IsInf(float64(to), 1) {
+			t.Errorf("To() = %v, %v", to, err)
+		}
+	})
+}
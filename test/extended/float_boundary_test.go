@@ -0,0 +1,195 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+// TestFloatToIntegerBoundary tests float to integer conversions around the exact power-of-two bounds.
+// The upper bound 2^(n-1) or 2^n is exactly representable as float64, while math.MaxInt64 and
+// math.MaxUint64 are rounded up to the bound when they are converted to float64.
+func TestFloatToIntegerBoundary(t *testing.T) {
+	type boundary struct {
+		from    float64
+		want    float64
+		wantErr bool
+	}
+
+	below := func(v float64) float64 { return math.Nextafter(v, math.Inf(-1)) }
+	above := func(v float64) float64 { return math.Nextafter(v, math.Inf(1)) }
+
+	signedBoundaries := func(bits int) []boundary {
+		upper := math.Ldexp(1, bits-1)
+		lower := -upper
+		return []boundary{
+			{upper, 0, true},
+			{above(upper), 0, true},
+			{below(upper), math.Trunc(below(upper)), false},
+			{lower, lower, false},
+			{below(lower), math.Trunc(below(lower)), math.Trunc(below(lower)) < lower},
+			{math.Min(lower-1, below(lower)), 0, true},
+			{0, 0, false},
+			{math.Copysign(0, -1), 0, false},
+		}
+	}
+
+	unsignedBoundaries := func(bits int) []boundary {
+		upper := math.Ldexp(1, bits)
+		return []boundary{
+			{upper, 0, true},
+			{above(upper), 0, true},
+			{below(upper), math.Trunc(below(upper)), false},
+			{0, 0, false},
+			{-0.5, 0, false},
+			{-1, 0, true},
+			{below(-1), 0, true},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		boundaries []boundary
+		cast       func(from float64) (float64, error)
+	}{
+		{"int8", signedBoundaries(8), func(from float64) (float64, error) {
+			var to int8
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"int16", signedBoundaries(16), func(from float64) (float64, error) {
+			var to int16
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"int32", signedBoundaries(32), func(from float64) (float64, error) {
+			var to int32
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"int64", signedBoundaries(64), func(from float64) (float64, error) {
+			var to int64
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"int", signedBoundaries(strconv.IntSize), func(from float64) (float64, error) {
+			var to int
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"uint8", unsignedBoundaries(8), func(from float64) (float64, error) {
+			var to uint8
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"uint16", unsignedBoundaries(16), func(from float64) (float64, error) {
+			var to uint16
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"uint32", unsignedBoundaries(32), func(from float64) (float64, error) {
+			var to uint32
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"uint64", unsignedBoundaries(64), func(from float64) (float64, error) {
+			var to uint64
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"uint", unsignedBoundaries(strconv.IntSize), func(from float64) (float64, error) {
+			var to uint
+			err := safecast.FromFloat64(from, &to)
+			return float64(to), err
+		}},
+		{"ToInt64", signedBoundaries(64), func(from float64) (float64, error) {
+			var to int64
+			err := safecast.ToInt64(from, &to)
+			return float64(to), err
+		}},
+		{"ToInt", signedBoundaries(strconv.IntSize), func(from float64) (float64, error) {
+			var to int
+			err := safecast.ToInt(from, &to)
+			return float64(to), err
+		}},
+		{"ToUint64", unsignedBoundaries(64), func(from float64) (float64, error) {
+			var to uint64
+			err := safecast.ToUint64(from, &to)
+			return float64(to), err
+		}},
+		{"ToUint", unsignedBoundaries(strconv.IntSize), func(from float64) (float64, error) {
+			var to uint
+			err := safecast.ToUint(from, &to)
+			return float64(to), err
+		}},
+		{"ToInt8", signedBoundaries(8), func(from float64) (float64, error) {
+			var to int8
+			err := safecast.ToInt8(from, &to)
+			return float64(to), err
+		}},
+		{"ToUint32", unsignedBoundaries(32), func(from float64) (float64, error) {
+			var to uint32
+			err := safecast.ToUint32(from, &to)
+			return float64(to), err
+		}},
+	}
+
+	for _, test := range tests {
+		for _, b := range test.boundaries {
+			t.Run(fmt.Sprintf("%s(%v)", test.name, b.from), func(t *testing.T) {
+				to, err := test.cast(b.from)
+				if b.wantErr {
+					if err == nil {
+						t.Errorf("%v => %v, expected error", b.from, to)
+					}
+					return
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if to != b.want {
+					t.Errorf("%v => %v, want %v", b.from, to, b.want)
+				}
+			})
+		}
+	}
+}
+
+func TestFloatToIntegerMaxValues(t *testing.T) {
+	var i64 int64
+	if err := safecast.FromFloat64(float64(math.MaxInt64), &i64); err == nil {
+		t.Errorf("FromFloat64(float64(math.MaxInt64)) = %v, expected overflow error", i64)
+	}
+	if err := safecast.FromFloat64(9223372036854775808.0, &i64); err == nil {
+		t.Errorf("FromFloat64(2^63) = %v, expected overflow error", i64)
+	}
+	if err := safecast.FromFloat64(float64(math.MinInt64), &i64); err != nil || i64 != math.MinInt64 {
+		t.Errorf("FromFloat64(float64(math.MinInt64)) = %v, %v", i64, err)
+	}
+
+	var u64 uint64
+	if err := safecast.FromFloat64(float64(math.MaxUint64), &u64); err == nil {
+		t.Errorf("FromFloat64(float64(math.MaxUint64)) = %v, expected overflow error", u64)
+	}
+	if err := safecast.ToUint64(float32(math.MaxUint64), &u64); err == nil {
+		t.Errorf("ToUint64(float32(math.MaxUint64)) = %v, expected overflow error", u64)
+	}
+}
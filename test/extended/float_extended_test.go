@@ -50,14 +50,14 @@ func TestFromFloat64Extended(t *testing.T) {
 
 		// Float conversions
 		{"float64 to float32 valid", 3.14, float32(0), false},
-		{"float64 to float32 overflow", math.MaxFloat64, float32(0), false}, // Fixed: doesn't cause overflow error
+		{"float64 to float32 overflow", math.MaxFloat64, float32(0), true}, // overflows float32
 		{"float64 to float64 valid", 3.14159, float64(0), false},
 
 		// String conversion
 		{"float64 to string", 3.14159, "", false},
 
 		// Special values
-		{"NaN to int", math.NaN(), int64(0), false},           // Fixed: NaN doesn't cause error
+		{"NaN to int", math.NaN(), int64(0), true},            // NaN causes error
 		{"Inf to int", math.Inf(1), int64(0), true},           // Fixed: Inf causes error
 		{"Negative Inf to int", math.Inf(-1), int64(0), true}, // Fixed: Negative Inf causes error
 	}
@@ -160,7 +160,7 @@ func TestToFloat32Extended(t *testing.T) {
 		// Various input types
 		{"int to float32", 42, false},
 		{"float64 to float32 valid", 3.14, false},
-		{"float64 to float32 overflow", math.MaxFloat64, false}, // Fixed: doesn't cause overflow error
+		{"float64 to float32 overflow", math.MaxFloat64, true}, // overflows float32
 		{"string valid to float32", "3.14", false},
 		{"string invalid to float32", "invalid", true},
 		{"bool true to float32", true, true},   // Fixed: bool to float32 causes error
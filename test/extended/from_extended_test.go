@@ -94,7 +94,7 @@ func TestFrom_ComprehensiveTypeHandling(t *testing.T) {
 		// float64 and *float64 - comprehensive coverage
 		{"float64 to string", 2.71828, func() *string { var s string; return &s }(), "2.71828", false},
 		{"*float64 to string", func() *float64 { f := 2.71828; return &f }(), func() *string { var s string; return &s }(), "2.71828", false},
-		// {"float64 NaN to int32", math.NaN(), func() *int32 { var i int32; return &i }(), int32(0), false}, // Removed - platform dependent
+		{"float64 NaN to int32", math.NaN(), func() *int32 { var i int32; return &i }(), int32(0), true},
 		{"float64 +Inf to int32", math.Inf(1), func() *int32 { var i int32; return &i }(), int32(0), true},
 		{"float64 -Inf to int32", math.Inf(-1), func() *int32 { var i int32; return &i }(), int32(0), true},
 
@@ -743,18 +743,19 @@ func TestToInt64_Comprehensive(t *testing.T) {
 		{"*uint overflow", func() any { i := uint(math.MaxUint64); return &i }(), 0, true},
 		{"*uint64 overflow", func() any { i := uint64(math.MaxUint64); return &i }(), 0, true},
 
-		// Float overflow/underflow - ToInt64 - クランプされる
-		{"float32 underflow", float32(-1e20), -9223372036854775808, false}, // クランップされる
-		{"float64 underflow", -1e20, -9223372036854775808, false},          // クランップされる
+		// Float overflow/underflow - ToInt64
+		{"float32 underflow", float32(-1e20), 0, true},
+		{"float64 underflow", -1e20, 0, true},
 
 		// Special float values - ToInt64
-		{"float64 -Inf", math.Inf(-1), -9223372036854775808, false}, // -Inf クランプされる
+		{"float64 -Inf", math.Inf(-1), 0, true}, // -Inf causes error
+		{"float64 NaN", math.NaN(), 0, true},    // NaN causes error
 
 		// Invalid string cases
 		{"string invalid", "not_a_number", 0, true},
 		{"string empty", "", 0, true},
-		{"string float", "3.14", 3, false},                                         // float string conversion succeeds
-		{"string underflow", "-99223372036854775809", -9223372036854775808, false}, // large negative conversion
+		{"string float", "3.14", 3, false},                     // float string conversion succeeds
+		{"string underflow", "-99223372036854775809", 0, true}, // large negative conversion
 
 		// Invalid []byte cases
 		{"[]byte invalid", []byte("invalid"), 0, true},
@@ -976,7 +977,7 @@ func TestToUint64_Comprehensive(t *testing.T) {
 		// {"float64 overflow", 1e20, 9223372036854775807, false},          // Removed - platform dependent
 
 		// Special float values - Remove problematic cases
-		// {"float64 NaN", math.NaN(), 0, false},                     // Removed - platform dependent
+		{"float64 NaN", math.NaN(), 0, true}, // NaN causes error
 		// {"float64 +Inf", math.Inf(1), 9223372036854775807, false}, // Removed - platform dependent
 		{"float64 -Inf", math.Inf(-1), 0, true}, // -Inf causes error
 
@@ -1094,7 +1095,7 @@ func TestToUint_Comprehensive(t *testing.T) {
 		// {"float64 overflow", 1e20, 9223372036854775807, false},          // Removed - platform dependent
 
 		// Special float values - Remove problematic cases
-		// {"float64 NaN", math.NaN(), 0, false},                     // Removed - platform dependent
+		{"float64 NaN", math.NaN(), 0, true}, // NaN causes error
 		// {"float64 +Inf", math.Inf(1), 9223372036854775807, false}, // Removed - platform dependent
 		{"float64 -Inf", math.Inf(-1), 0, true}, // -Inf causes error
 
@@ -0,0 +1,200 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestParseISO8601(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		s    string
+		want time.Time
+	}{
+		// calendar dates
+		{"2024-01-02", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"20240102", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// reduced precision
+		{"2024-05", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// ordinal dates
+		{"2024-045", time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)},
+		{"2024045", time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)},
+		{"2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		// week dates
+		{"2024-W12-3", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{"2024W123", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{"2024-W12", time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)},
+		{"2020-W53-7", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"2025-W01-1", time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		// times
+		{"20240102T150405Z", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05Z", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04", time.Date(2024, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{"2024-01-02T15", time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)},
+		{"20240102T1504", time.Date(2024, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{"2024-W12-3T10:00Z", time.Date(2024, 3, 20, 10, 0, 0, 0, time.UTC)},
+		{"2024-045T10:00:00Z", time.Date(2024, 2, 14, 10, 0, 0, 0, time.UTC)},
+		{"2024-01-02T24:00", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		// fractions
+		{"2024-01-02T15:04:05.123456789Z", time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC)},
+		{"2024-01-02T15:04:05,5Z", time.Date(2024, 1, 2, 15, 4, 5, 500000000, time.UTC)},
+		{"2024-01-02T15:04,5Z", time.Date(2024, 1, 2, 15, 4, 30, 0, time.UTC)},
+		{"2024-01-02T15.25Z", time.Date(2024, 1, 2, 15, 15, 0, 0, time.UTC)},
+		{"20240102T150405,25Z", time.Date(2024, 1, 2, 15, 4, 5, 250000000, time.UTC)},
+		// offsets
+		{"2024-01-02T15:04:05+09:00", time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05+09", time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)},
+		{"20240102T150405-0530", time.Date(2024, 1, 2, 20, 34, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05-00:00", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		v, err := safecast.ParseISO8601(tt.s, nil)
+		if err != nil {
+			t.Errorf("ParseISO8601(%q) = %v", tt.s, err)
+			continue
+		}
+		if !v.Equal(tt.want) {
+			t.Errorf("ParseISO8601(%q) = %v, want %v", tt.s, v, tt.want)
+		}
+	}
+
+	v, err := safecast.ParseISO8601("2024-01-02T15:04:05", jst)
+	if err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) || v.Location() != jst {
+		t.Errorf("ParseISO8601() = %v, %v", v, err)
+	}
+	v, err = safecast.ParseISO8601("2024-01-02T15:04:05+09:00", jst)
+	if err != nil || v.Location() != jst {
+		t.Errorf("ParseISO8601() = %v, %v", v, err)
+	}
+
+	errTests := []string{
+		"",
+		"24",
+		"2024-1-2",
+		"2024-0102",
+		"202401-02",
+		"202401",
+		"2024-W123",
+		"2024W12-3",
+		"2024T10",
+		"2024-05T10",
+		"2024-W12T10",
+		"2024-01-02T",
+		"2024-01-02T1",
+		"2024-01-02T150",
+		"2024-01-02T15:04:05:06",
+		"2024-01-02T1504:05",
+		"2024-01-02T150405",
+		"20240102T15:04:05",
+		"2024-01-02T15:04:05.",
+		"2024-01-02T15:04:05.1a",
+		"2024-01-02T15:04:05+0900",
+		"20240102T150405+09:00",
+		"2024-01-02T15:04:05+9",
+		"2024-01-02T15:04:05Zulu",
+		"2024-01-02 15:04:05",
+		"1700000000",
+	}
+	for _, s := range errTests {
+		v, err := safecast.ParseISO8601(s, nil)
+		var castErr *safecast.CastError
+		if !errors.As(err, &castErr) || castErr.Reason != safecast.ReasonSyntax || !errors.Is(err, safecast.ErrCast) {
+			t.Errorf("ParseISO8601(%q) = %v, %v, want CastError", s, v, err)
+		}
+	}
+
+	// A value out of range is reported with a range reason.
+	rangeTests := []struct {
+		s      string
+		reason safecast.Reason
+	}{
+		{"2024-13", safecast.ReasonOverflow},
+		{"2024-00", safecast.ReasonUnderflow},
+		{"2024-02-30", safecast.ReasonOverflow},
+		{"2023-02-29", safecast.ReasonOverflow},
+		{"2024-01-00", safecast.ReasonUnderflow},
+		{"2023-366", safecast.ReasonOverflow},
+		{"2024-000", safecast.ReasonUnderflow},
+		{"2024-W00", safecast.ReasonUnderflow},
+		{"2024-W53", safecast.ReasonOverflow},
+		{"2024-W12-8", safecast.ReasonOverflow},
+		{"2024-W12-0", safecast.ReasonUnderflow},
+		{"2024-01-02T25:00", safecast.ReasonOverflow},
+		{"2024-01-02T15:60", safecast.ReasonOverflow},
+		{"2024-01-02T15:04:60", safecast.ReasonOverflow},
+		// The leap second is rejected, since time.Time cannot represent it.
+		{"2016-12-31T23:59:60Z", safecast.ReasonOverflow},
+		{"2024-01-02T24:00:01", safecast.ReasonOverflow},
+		{"2024-01-02T24:00,5", safecast.ReasonOverflow},
+		{"2024-01-02T15:04:05+24:00", safecast.ReasonOverflow},
+	}
+	for _, tt := range rangeTests {
+		v, err := safecast.ParseISO8601(tt.s, nil)
+		var castErr *safecast.CastError
+		if !errors.As(err, &castErr) || castErr.Reason != tt.reason || !errors.Is(err, safecast.ErrCast) {
+			t.Errorf("ParseISO8601(%q) = %v, %v, want %v", tt.s, v, err, tt.reason)
+		}
+	}
+}
+
+func TestToTimeISO8601(t *testing.T) {
+	var v time.Time
+	if err := safecast.ToTime("2024-W12-3T10:00:00,5Z", &v); err != nil || !v.Equal(time.Date(2024, 3, 20, 10, 0, 0, 500000000, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.ToTime("2024-045", &v); err != nil || !v.Equal(time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	// The layouts take precedence over ISO 8601 when they are specified.
+	if err := safecast.ToTime("2024-045", &v, safecast.DateTime); err == nil {
+		t.Errorf("ToTime() = %v, want error", v)
+	}
+	if err := safecast.ToTime("2024-01-02", &v, safecast.ISO8601DateLayout); err != nil || !v.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	// Numeric strings are parsed only as ISO 8601 dates, and as Unix epoch numbers with the unit.
+	if err := safecast.ToTime("1700000000", &v); err == nil {
+		t.Errorf("ToTime() = %v, want error", v)
+	}
+	if err := safecast.ToWith("1700000000", &v, safecast.WithTimeUnit(time.Second)); err != nil || !v.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	if err := safecast.ToTime("20240102", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	// The range error of an ISO 8601 string is reported instead of the mismatch of SupportedTimeLayouts.
+	for _, s := range []string{"2024-02-30", "2024-W54", "2023-366", "2024-01-02T25:00:00Z"} {
+		err := safecast.ToTime(s, &v)
+		if !errors.Is(err, safecast.ErrCast) || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("ToTime(%q) = %v, want range error", s, err)
+		}
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	if err := safecast.ToTimeInLocation("20240102T150405", &v, jst); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTimeInLocation() = %v, %v", v, err)
+	}
+	if r, err := safecast.Compare(time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), "2024-W12"); err != nil || r != 0 {
+		t.Errorf("Compare() = %v, %v", r, err)
+	}
+}
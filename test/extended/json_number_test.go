@@ -0,0 +1,103 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestJSONNumber(t *testing.T) {
+	var u64 uint64
+	if err := safecast.ToUint64(json.Number("18446744073709551615"), &u64); err != nil || u64 != math.MaxUint64 {
+		t.Errorf("ToUint64() = %v, %v", u64, err)
+	}
+	if err := safecast.ToUint64(json.Number("18446744073709551616"), &u64); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("ToUint64() = %v, want ErrOverflow", err)
+	}
+	var i64 int64
+	if err := safecast.ToInt64(json.Number("-9223372036854775808"), &i64); err != nil || i64 != math.MinInt64 {
+		t.Errorf("ToInt64() = %v, %v", i64, err)
+	}
+	if err := safecast.ToInt64(json.Number("9007199254740993"), &i64); err != nil || i64 != 9007199254740993 {
+		t.Errorf("ToInt64() = %v, %v", i64, err)
+	}
+	if err := safecast.ToInt64(json.Number("1e3"), &i64); err != nil || i64 != 1000 {
+		t.Errorf("ToInt64() = %v, %v", i64, err)
+	}
+	if err := safecast.ToInt64(json.Number("1e30"), &i64); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("ToInt64() = %v, want ErrOverflow", err)
+	}
+	if err := safecast.ToInt64(json.Number("abc"), &i64); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("ToInt64() = %v, want ErrSyntax", err)
+	}
+	var i8 int8
+	if err := safecast.ToInt8(json.Number("128"), &i8); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("ToInt8() = %v, want ErrOverflow", err)
+	}
+	var u8 uint8
+	if err := safecast.ToUint8(json.Number("-1"), &u8); !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("ToUint8() = %v, want ErrCast", err)
+	}
+	var f64 float64
+	if err := safecast.ToFloat64(json.Number("1.25e2"), &f64); err != nil || f64 != 125 {
+		t.Errorf("ToFloat64() = %v, %v", f64, err)
+	}
+	var f32 float32
+	if err := safecast.ToFloat32(json.Number("0.5"), &f32); err != nil || f32 != 0.5 {
+		t.Errorf("ToFloat32() = %v, %v", f32, err)
+	}
+
+	var i int
+	if err := safecast.To(json.Number("42"), &i); err != nil || i != 42 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	if err := safecast.From(json.Number("2.5e1"), &i); err != nil || i != 25 {
+		t.Errorf("From() = %v, %v", i, err)
+	}
+	if err := safecast.ToWith(json.Number("2.5"), &i, safecast.WithRoundingMode(safecast.RoundHalfAwayFromZero)); err != nil || i != 3 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+	var s string
+	if err := safecast.To(json.Number("1.50"), &s); err != nil || s != "1.50" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	var id UserID
+	if err := safecast.To(json.Number("7"), &id); err != nil || id != 7 {
+		t.Errorf("To() = %v, %v", id, err)
+	}
+}
+
+func TestJSONNumberDecoder(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`{"id": 18446744073709551615, "ratio": 0.25}`))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		t.Fatal(err)
+	}
+	id, err := safecast.Cast[uint64](m["id"])
+	if err != nil || id != math.MaxUint64 {
+		t.Errorf("Cast() = %v, %v", id, err)
+	}
+	ratio, err := safecast.Cast[float32](m["ratio"])
+	if err != nil || ratio != 0.25 {
+		t.Errorf("Cast() = %v, %v", ratio, err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestOrderPredicates(t *testing.T) {
+	tests := []struct {
+		v1, v2                       any
+		less, lessEq, greater, grtEq bool
+	}{
+		{int8(1), 300, true, true, false, false},
+		{uint64(math.MaxUint64), -1, false, false, true, true},
+		{"10", 5, false, false, true, true},
+		{2.5, big.NewRat(5, 2), false, true, false, true},
+		{"abc", "abd", true, true, false, false},
+		{"3", 5, false, false, true, true},
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), true, true, false, false},
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "2022-01-02T00:00:00Z", false, false, true, true},
+		{nil, false, true, true, false, false},
+	}
+	for _, tt := range tests {
+		if got, err := safecast.Less(tt.v1, tt.v2); err != nil || got != tt.less {
+			t.Errorf("Less(%v, %v) = %v, %v", tt.v1, tt.v2, got, err)
+		}
+		if got, err := safecast.LessOrEqual(tt.v1, tt.v2); err != nil || got != tt.lessEq {
+			t.Errorf("LessOrEqual(%v, %v) = %v, %v", tt.v1, tt.v2, got, err)
+		}
+		if got, err := safecast.Greater(tt.v1, tt.v2); err != nil || got != tt.greater {
+			t.Errorf("Greater(%v, %v) = %v, %v", tt.v1, tt.v2, got, err)
+		}
+		if got, err := safecast.GreaterOrEqual(tt.v1, tt.v2); err != nil || got != tt.grtEq {
+			t.Errorf("GreaterOrEqual(%v, %v) = %v, %v", tt.v1, tt.v2, got, err)
+		}
+	}
+	if _, err := safecast.Less([]int{1}, []int{2}); err == nil {
+		t.Errorf("Less() should fail for unsupported types")
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		v, lo, hi any
+		want      bool
+	}{
+		{5, 1, 10, true},
+		{1, 1, 10, true},
+		{10, uint8(1), 10.0, true},
+		{int8(-1), uint(0), 10, false},
+		{"7", 1, 10, false},
+		{11, 1, "10", true},
+		{"b", "a", "c", true},
+	}
+	for _, tt := range tests {
+		got, err := safecast.Between(tt.v, tt.lo, tt.hi)
+		if err != nil {
+			t.Errorf("Between(%v, %v, %v) = %v", tt.v, tt.lo, tt.hi, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Between(%v, %v, %v) = %v, want %v", tt.v, tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if v, err := safecast.Min(3, int8(-2), uint64(math.MaxUint64), 2.5); err != nil || v != int8(-2) {
+		t.Errorf("Min() = %v, %v", v, err)
+	}
+	if v, err := safecast.Max(3, int8(-2), uint64(math.MaxUint64), 2.5); err != nil || v != uint64(math.MaxUint64) {
+		t.Errorf("Max() = %v, %v", v, err)
+	}
+	if _, err := safecast.Min(); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("Min() = %v, want ErrNil", err)
+	}
+	if _, err := safecast.Max(); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("Max() = %v, want ErrNil", err)
+	}
+	// Min and Max agree with Less.
+	if less, err := safecast.Less("3", 5); err != nil || less {
+		t.Errorf("Less(\"3\", 5) = %v, %v", less, err)
+	}
+	if v, err := safecast.Max("3", 5); err != nil || v != "3" {
+		t.Errorf("Max() = %v, %v", v, err)
+	}
+	if v, err := safecast.Min("3", 5); err != nil || v != 5 {
+		t.Errorf("Min() = %v, %v", v, err)
+	}
+	if v, err := safecast.Max("a", 100, true); err != nil || v != "a" {
+		t.Errorf("Max() = %v, %v", v, err)
+	}
+	if v, err := safecast.Min("a", 100, true, nil); err != nil || v != nil {
+		t.Errorf("Min() = %v, %v", v, err)
+	}
+	if _, err := safecast.Max(struct{}{}, struct{}{}); err == nil {
+		t.Errorf("Max() should fail for unsupported types")
+	}
+}
+
+func TestSortAny(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := []any{
+		ts, "b", []byte("z"), 3, true, nil, int8(-1), "a", uint64(math.MaxUint64), false, 2.5, "10",
+	}
+	want := []any{
+		nil, false, true, int8(-1), 2.5, 3, uint64(math.MaxUint64), "10", "a", "b", []byte("z"), ts,
+	}
+	if err := safecast.SortAny(values); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("SortAny() = %v, want %v", values, want)
+	}
+
+	// The order is total, so sorting any permutation gives the same result.
+	for i := range values {
+		rotated := append(append([]any{}, values[i:]...), values[:i]...)
+		if err := safecast.SortAny(rotated); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(rotated, want) {
+			t.Errorf("SortAny() = %v, want %v", rotated, want)
+		}
+	}
+
+	if err := safecast.SortAny([]any{[]int{1}, []int{2}}); err == nil {
+		t.Errorf("SortAny() should fail for unsupported types")
+	}
+
+	// The values are left unchanged on errors.
+	values = []any{3, []int{2}, 1, []int{1}, "a"}
+	want = []any{3, []int{2}, 1, []int{1}, "a"}
+	if err := safecast.SortAny(values); err == nil {
+		t.Errorf("SortAny() should fail for unsupported types")
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("SortAny() = %v, want %v", values, want)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestPrecisionCheck(t *testing.T) {
+	tests := []struct {
+		from    any
+		to      any
+		wantErr bool
+	}{
+		// int64 to float64
+		{int64(1 << 53), new(float64), false},
+		{int64(1<<53 + 1), new(float64), true},
+		{int64(-(1<<53 + 1)), new(float64), true},
+		{int64(math.MaxInt64), new(float64), true},
+		{int64(math.MinInt64), new(float64), false},
+		{int(1<<53 + 1), new(float64), true},
+		{func() any { v := int64(1<<53 + 1); return &v }(), new(float64), true},
+		// uint64 to float64
+		{uint64(1 << 63), new(float64), false},
+		{uint64(math.MaxUint64), new(float64), true},
+		{uint(1<<53 + 1), new(float64), true},
+		// int32 to float32
+		{int32(16777216), new(float32), false},
+		{int32(16777217), new(float32), true},
+		{uint32(16777217), new(float32), true},
+		{int16(math.MaxInt16), new(float32), false},
+		{int32(16777217), new(float64), false},
+		// float64 to float32
+		{0.5, new(float32), false},
+		{0.1, new(float32), true},
+		{math.Inf(1), new(float32), false},
+		{math.NaN(), new(float32), false},
+		{0.1, new(float64), false},
+		{float32(0.1), new(float32), false},
+		// strings
+		{"9007199254740993", new(float64), true},
+		{"9007199254740992", new(float64), false},
+		{"0.1", new(float64), false},
+		// non-float destinations are not checked
+		{int64(1<<53 + 1), new(int64), false},
+		{int64(1<<53 + 1), new(string), false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T(%v)=>%T", test.from, test.from, test.to), func(t *testing.T) {
+			err := safecast.ToWith(test.from, test.to, safecast.WithPrecisionCheck())
+			if test.wantErr {
+				if !errors.Is(err, safecast.ErrPrecisionLoss) {
+					t.Errorf("To() = %v, want %v", err, safecast.ErrPrecisionLoss)
+				}
+				if !errors.Is(err, safecast.ErrCast) {
+					t.Errorf("To() = %v, want %v", err, safecast.ErrCast)
+				}
+				return
+			}
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPrecisionCheckFrom(t *testing.T) {
+	var f64 float64
+	if err := safecast.FromWith(int64(1<<53+1), &f64, safecast.WithPrecisionCheck()); !errors.Is(err, safecast.ErrPrecisionLoss) {
+		t.Errorf("From() = %v, want %v", err, safecast.ErrPrecisionLoss)
+	}
+	if err := safecast.From(int64(1<<53+1), &f64); err != nil {
+		t.Errorf("From() = %v, want no error without the precision check", err)
+	}
+	if v, err := safecast.Cast[float32](int32(16777217), safecast.WithPrecisionCheck()); !errors.Is(err, safecast.ErrPrecisionLoss) {
+		t.Errorf("Cast[float32]() = %v, %v", v, err)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestRoundingMode(t *testing.T) {
+	tests := []struct {
+		from     any
+		mode     safecast.RoundingMode
+		expected int64
+	}{
+		{3.7, safecast.RoundTruncate, 3},
+		{-3.7, safecast.RoundTruncate, -3},
+		{3.7, safecast.RoundFloor, 3},
+		{-3.2, safecast.RoundFloor, -4},
+		{3.2, safecast.RoundCeil, 4},
+		{-3.7, safecast.RoundCeil, -3},
+		{2.5, safecast.RoundHalfEven, 2},
+		{3.5, safecast.RoundHalfEven, 4},
+		{-2.5, safecast.RoundHalfEven, -2},
+		{2.5, safecast.RoundHalfAwayFromZero, 3},
+		{-2.5, safecast.RoundHalfAwayFromZero, -3},
+		{2.4, safecast.RoundHalfAwayFromZero, 2},
+		{float32(1.5), safecast.RoundHalfEven, 2},
+		{"3.7", safecast.RoundCeil, 4},
+		{"3.7", safecast.RoundTruncate, 3},
+		{"-3.5", safecast.RoundHalfAwayFromZero, -4},
+		{[]byte("2.5"), safecast.RoundHalfEven, 2},
+		{"9007199254740993.5", safecast.RoundFloor, 9007199254740993},
+		{"9007199254740993.5", safecast.RoundHalfEven, 9007199254740994},
+		{"-9007199254740993.5", safecast.RoundTruncate, -9007199254740993},
+		{"3.0000000000000001", safecast.RoundCeil, 4},
+		{"-2.5e-2000", safecast.RoundFloor, -1},
+		{"2.5e-2000", safecast.RoundHalfAwayFromZero, 0},
+		{"42", safecast.RoundExact, 42},
+		{"4.2e1", safecast.RoundExact, 42},
+		{"9007199254740993.0", safecast.RoundExact, 9007199254740993},
+		{4.0, safecast.RoundExact, 4},
+		{7, safecast.RoundExact, 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String(), func(t *testing.T) {
+			var to int64
+			if err := safecast.ToWith(test.from, &to, safecast.WithRoundingMode(test.mode)); err != nil {
+				t.Error(err)
+				return
+			}
+			if to != test.expected {
+				t.Errorf("To(%v, %s) = %v, want %v", test.from, test.mode, to, test.expected)
+			}
+		})
+	}
+}
+
+func TestRoundingModeExact(t *testing.T) {
+	tests := []struct {
+		from any
+		to   any
+	}{
+		{3.7, new(int64)},
+		{-0.5, new(int8)},
+		{float32(1.25), new(uint16)},
+		{"3.7", new(int)},
+		{[]byte("0.1"), new(uint64)},
+		{"9007199254740993.5", new(int64)},
+		{"3.0000000000000001", new(int64)},
+		{"1e-2000", new(int32)},
+	}
+
+	for _, test := range tests {
+		err := safecast.ToWith(test.from, test.to, safecast.WithRoundingMode(safecast.RoundExact))
+		if !errors.Is(err, safecast.ErrFractional) {
+			t.Errorf("To(%v) = %v, want %v", test.from, err, safecast.ErrFractional)
+		}
+		if !errors.Is(err, safecast.ErrCast) {
+			t.Errorf("To(%v) = %v, want %v", test.from, err, safecast.ErrCast)
+		}
+		err = safecast.FromWith(test.from, test.to, safecast.WithRoundingMode(safecast.RoundExact))
+		if !errors.Is(err, safecast.ErrFractional) {
+			t.Errorf("From(%v) = %v, want %v", test.from, err, safecast.ErrFractional)
+		}
+	}
+}
+
+func TestRoundingModeRange(t *testing.T) {
+	var to int8
+	if err := safecast.ToWith(127.5, &to, safecast.WithRoundingMode(safecast.RoundHalfAwayFromZero)); err == nil {
+		t.Errorf("To() = %v, expected overflow error", to)
+	}
+	if err := safecast.ToWith(127.5, &to, safecast.WithRoundingMode(safecast.RoundFloor)); err != nil || to != 127 {
+		t.Errorf("To() = %v, %v", to, err)
+	}
+	if err := safecast.ToWith("127.5", &to, safecast.WithRoundingMode(safecast.RoundHalfAwayFromZero)); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+	var i64 int64
+	if err := safecast.ToWith("9223372036854775807.5", &i64, safecast.WithRoundingMode(safecast.RoundCeil)); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+}
+
+func TestRoundingModeNonInteger(t *testing.T) {
+	var f float64
+	if err := safecast.ToWith(3.7, &f, safecast.WithRoundingMode(safecast.RoundExact)); err != nil || f != 3.7 {
+		t.Errorf("To() = %v, %v", f, err)
+	}
+	var s string
+	if err := safecast.ToWith(3.7, &s, safecast.WithRoundingMode(safecast.RoundFloor)); err != nil || s != "3.7" {
+		t.Errorf("To() = %v, %v", s, err)
+	}
+	if v, err := safecast.Cast[uint8](2.5, safecast.WithRoundingMode(safecast.RoundCeil)); err != nil || v != 3 {
+		t.Errorf("Cast[uint8]() = %v, %v", v, err)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestSaturate(t *testing.T) {
+	tests := []struct {
+		from    any
+		to      any
+		want    any
+		clamped bool
+	}{
+		// int.go
+		{300, new(int8), int8(math.MaxInt8), true},
+		{-300, new(int8), int8(math.MinInt8), true},
+		{100, new(int8), int8(100), false},
+		{uint64(math.MaxUint64), new(int16), int16(math.MaxInt16), true},
+		{int64(math.MinInt64), new(int32), int32(math.MinInt32), true},
+		{uint64(math.MaxUint64), new(int64), int64(math.MaxInt64), true},
+		{uint64(math.MaxUint64), new(int), int(math.MaxInt), true},
+		{"99999999999999999999", new(int64), int64(math.MaxInt64), true},
+		{"-99999999999999999999", new(int64), int64(math.MinInt64), true},
+		// uint.go
+		{-1, new(uint8), uint8(0), true},
+		{1000, new(uint8), uint8(math.MaxUint8), true},
+		{int64(math.MinInt64), new(uint64), uint64(0), true},
+		{70000, new(uint16), uint16(math.MaxUint16), true},
+		{int64(math.MaxInt64), new(uint32), uint32(math.MaxUint32), true},
+		{-5, new(uint), uint(0), true},
+		{-1, new(uint16), uint16(0), true},
+		{int8(-128), new(uint32), uint32(0), true},
+		{"-1", new(uint64), uint64(0), true},
+		{-0.5e10, new(uint32), uint32(0), true},
+		{float32(-3.5), new(uint8), uint8(0), true},
+		{-7, new(int64), int64(-7), false},
+		// float.go
+		{1e20, new(int32), int32(math.MaxInt32), true},
+		{-1e20, new(uint64), uint64(0), true},
+		{1e300, new(float32), float32(math.MaxFloat32), true},
+		{-1e300, new(float32), float32(-math.MaxFloat32), true},
+		{math.Inf(1), new(int64), int64(math.MaxInt64), true},
+		{math.Inf(-1), new(int8), int8(math.MinInt8), true},
+		{1.5, new(float32), float32(1.5), false},
+		{"1e39", new(float32), float32(math.MaxFloat32), true},
+		{"-1e39", new(float32), float32(-math.MaxFloat32), true},
+		{uint64(math.MaxUint64), new(float32), float32(math.MaxUint64), false},
+		{"1e400", new(float64), float64(math.MaxFloat64), true},
+		{"-1e400", new(float64), float64(-math.MaxFloat64), true},
+		{float32(math.Inf(1)), new(int16), int16(math.MaxInt16), true},
+		{float32(math.Inf(-1)), new(uint16), uint16(0), true},
+		{math.MaxFloat64, new(float64), float64(math.MaxFloat64), false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v=>%T", test.from, test.to), func(t *testing.T) {
+			clamped, err := safecast.Saturate(test.from, test.to)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if clamped != test.clamped {
+				t.Errorf("clamped = %v, want %v", clamped, test.clamped)
+			}
+			if got := reflect.ValueOf(test.to).Elem().Interface(); got != test.want {
+				t.Errorf("Saturate(%v) = %v, want %v", test.from, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSaturateErrors(t *testing.T) {
+	var i8 int8
+	if _, err := safecast.Saturate(math.NaN(), &i8); !errors.Is(err, safecast.ErrNaN) {
+		t.Errorf("Saturate(NaN) = %v, want %v", err, safecast.ErrNaN)
+	}
+	if _, err := safecast.Saturate("abc", &i8); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("Saturate(abc) = %v, want %v", err, safecast.ErrSyntax)
+	}
+	if _, err := safecast.Saturate(struct{}{}, &i8); !errors.Is(err, safecast.ErrUnsupportedType) {
+		t.Errorf("Saturate(struct{}) = %v, want %v", err, safecast.ErrUnsupportedType)
+	}
+}
+
+func TestSaturateOptions(t *testing.T) {
+	type Level uint8
+	var level Level
+	clamped, err := safecast.Saturate(1000, &level)
+	if err != nil || !clamped || level != math.MaxUint8 {
+		t.Errorf("Saturate() = %v, %v, %v", level, clamped, err)
+	}
+
+	var i8 int8
+	clamped, err = safecast.Saturate(127.5, &i8, safecast.WithRoundingMode(safecast.RoundCeil))
+	if err != nil || !clamped || i8 != math.MaxInt8 {
+		t.Errorf("Saturate() = %v, %v, %v", i8, clamped, err)
+	}
+
+	var f32 float32
+	clamped, err = safecast.Saturate(1e-300, &f32, safecast.WithFloatUnderflowError())
+	if err != nil || !clamped || f32 != 0 {
+		t.Errorf("Saturate() = %v, %v, %v", f32, clamped, err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	sentinels := []error{
+		safecast.ErrOverflow,
+		safecast.ErrUnderflow,
+		safecast.ErrSyntax,
+		safecast.ErrUnsupportedType,
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		// int.go
+		{"ToInt8 overflow", func() error { var to int8; return safecast.ToInt8(128, &to) }(), safecast.ErrOverflow},
+		{"ToInt16 underflow", func() error { var to int16; return safecast.ToInt16(math.MinInt32, &to) }(), safecast.ErrUnderflow},
+		{"ToInt32 syntax", func() error { var to int32; return safecast.ToInt32("1x", &to) }(), safecast.ErrSyntax},
+		{"ToInt64 unsupported", func() error { var to int64; return safecast.ToInt64(struct{}{}, &to) }(), safecast.ErrUnsupportedType},
+		{"FromInt64 overflow", func() error { var to int8; return safecast.FromInt64(math.MaxInt64, &to) }(), safecast.ErrOverflow},
+		{"FromInt unsupported", func() error { var to time.Time; return safecast.FromInt(1, &to) }(), safecast.ErrUnsupportedType},
+		// uint.go
+		{"ToUint8 underflow", func() error { var to uint8; return safecast.ToUint8(-1, &to) }(), safecast.ErrUnderflow},
+		{"ToUint16 overflow", func() error { var to uint16; return safecast.ToUint16(uint64(math.MaxUint64), &to) }(), safecast.ErrOverflow},
+		{"ToUint64 syntax", func() error { var to uint64; return safecast.ToUint64("abc", &to) }(), safecast.ErrSyntax},
+		{"FromUint64 overflow", func() error { var to int64; return safecast.FromUint64(math.MaxUint64, &to) }(), safecast.ErrOverflow},
+		// float.go
+		{"FromFloat64 overflow", func() error { var to uint32; return safecast.FromFloat64(1e20, &to) }(), safecast.ErrOverflow},
+		{"FromFloat64 underflow", func() error { var to int32; return safecast.FromFloat64(-1e20, &to) }(), safecast.ErrUnderflow},
+		{"ToFloat32 overflow", func() error { var to float32; return safecast.ToFloat32(1e300, &to) }(), safecast.ErrOverflow},
+		{"ToFloat64 syntax", func() error { var to float64; return safecast.ToFloat64("1.2.3", &to) }(), safecast.ErrSyntax},
+		{"ToFloat64 unsupported", func() error { var to float64; return safecast.ToFloat64(true, &to) }(), safecast.ErrUnsupportedType},
+		// string.go
+		{"FromString overflow", func() error { var to uint8; return safecast.FromString("256", &to) }(), safecast.ErrOverflow},
+		{"FromString underflow", func() error { var to int8; return safecast.FromString("-129", &to) }(), safecast.ErrUnderflow},
+		{"FromString syntax", func() error { var to int; return safecast.FromString("x", &to) }(), safecast.ErrSyntax},
+		{"FromString unsupported", func() error { var to time.Time; return safecast.FromString("x", &to) }(), safecast.ErrUnsupportedType},
+		// bool.go
+		{"ToBool syntax", func() error { var to bool; return safecast.ToBool("yes", &to) }(), safecast.ErrSyntax},
+		{"ToBool unsupported", func() error { var to bool; return safecast.ToBool(1.0, &to) }(), safecast.ErrUnsupportedType},
+		{"FromBool unsupported", func() error { var to float64; return safecast.FromBool(true, &to) }(), safecast.ErrUnsupportedType},
+		// time.go
+		{"ToTime syntax", func() error { var to time.Time; return safecast.ToTime("2022-1x-45", &to) }(), safecast.ErrSyntax},
+		{"ToTime overflow", func() error { var to time.Time; return safecast.ToTime("2022-13-45", &to) }(), safecast.ErrOverflow},
+		{"ToTime underflow", func() error { var to time.Time; return safecast.ToTime("2022-00-01", &to) }(), safecast.ErrUnderflow},
+		{"ToTime unsupported", func() error { var to time.Time; return safecast.ToTime(true, &to) }(), safecast.ErrUnsupportedType},
+		// bytes.go
+		{"ToBytes unsupported", func() error { var to []byte; return safecast.ToBytes(1, &to) }(), safecast.ErrUnsupportedType},
+		// to.go
+		{"To unsupported", safecast.To(1, new(struct{})), safecast.ErrUnsupportedType},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !errors.Is(test.err, safecast.ErrCast) {
+				t.Errorf("%v is not %v", test.err, safecast.ErrCast)
+			}
+			for _, sentinel := range sentinels {
+				is := errors.Is(test.err, sentinel)
+				if sentinel == test.want && !is {
+					t.Errorf("%v is not %v", test.err, sentinel)
+				}
+				if sentinel != test.want && is {
+					t.Errorf("%v is unexpectedly %v", test.err, sentinel)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+// upperString is a sql.Scanner which stores an upper case string.
+type upperString struct {
+	s string
+}
+
+func (u *upperString) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("not a string")
+	}
+	u.s = strings.ToUpper(s)
+	return nil
+}
+
+// failValuer is a driver.Valuer which always fails.
+type failValuer struct{}
+
+func (failValuer) Value() (driver.Value, error) {
+	return nil, errors.New("broken")
+}
+
+func TestDriverValuerSources(t *testing.T) {
+	var i8 int8
+	if err := safecast.ToInt8(sql.NullInt64{Int64: 100, Valid: true}, &i8); err != nil || i8 != 100 {
+		t.Errorf("ToInt8() = %v, %v", i8, err)
+	}
+	if err := safecast.ToInt8(sql.NullInt64{Int64: 1000, Valid: true}, &i8); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("ToInt8() = %v, want ErrOverflow", err)
+	}
+	var u64 uint64
+	if err := safecast.ToUint64(sql.NullInt64{Valid: false}, &u64); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("ToUint64() = %v, want ErrNil", err)
+	}
+	var f float64
+	if err := safecast.To(sql.NullFloat64{Float64: 1.5, Valid: true}, &f); err != nil || f != 1.5 {
+		t.Errorf("To() = %v, %v", f, err)
+	}
+	var s string
+	if err := safecast.ToString(sql.NullString{String: "abc", Valid: true}, &s); err != nil || s != "abc" {
+		t.Errorf("ToString() = %q, %v", s, err)
+	}
+	if err := safecast.ToString(sql.NullString{}, &s); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("ToString() = %v, want ErrNil", err)
+	}
+	var b bool
+	if err := safecast.From(sql.NullBool{Bool: true, Valid: true}, &b); err != nil || !b {
+		t.Errorf("From() = %v, %v", b, err)
+	}
+	now := time.Now()
+	var ts time.Time
+	if err := safecast.ToTime(sql.NullTime{Time: now, Valid: true}, &ts); err != nil || !ts.Equal(now) {
+		t.Errorf("ToTime() = %v, %v", ts, err)
+	}
+	var i32 int32
+	if err := safecast.To(sql.Null[int16]{V: -5, Valid: true}, &i32); err != nil || i32 != -5 {
+		t.Errorf("To() = %v, %v", i32, err)
+	}
+	var np *sql.NullInt64
+	if err := safecast.ToInt32(np, &i32); !errors.Is(err, safecast.ErrNil) {
+		t.Errorf("ToInt32() = %v, want ErrNil", err)
+	}
+	if err := safecast.ToInt32(failValuer{}, &i32); !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("ToInt32() = %v, want ErrCast", err)
+	}
+
+	// NULL values are cast to zero with WithNilAsZero.
+	i32 = 10
+	if err := safecast.ToWith(sql.NullInt32{}, &i32, safecast.WithNilAsZero()); err != nil || i32 != 0 {
+		t.Errorf("To() = %v, %v", i32, err)
+	}
+	s = "abc"
+	if err := safecast.NewCaster(safecast.WithNilAsZero()).From(sql.Null[string]{}, &s); err != nil || s != "" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+}
+
+func TestNullableDestinations(t *testing.T) {
+	var ni sql.NullInt64
+	if err := safecast.To("42", &ni); err != nil || !ni.Valid || ni.Int64 != 42 {
+		t.Errorf("To() = %v, %v", ni, err)
+	}
+	if err := safecast.To(nil, &ni); err != nil || ni.Valid {
+		t.Errorf("To(nil) = %v, %v", ni, err)
+	}
+	var nb sql.NullByte
+	if err := safecast.To(300, &nb); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("To() = %v, want ErrOverflow", err)
+	}
+	var n16 sql.Null[int16]
+	if err := safecast.From(uint64(math.MaxUint64), &n16); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("From() = %v, want ErrOverflow", err)
+	}
+	if err := safecast.From(int64(-7), &n16); err != nil || !n16.Valid || n16.V != -7 {
+		t.Errorf("From() = %v, %v", n16, err)
+	}
+	var ns sql.NullString
+	if err := safecast.To(sql.NullInt64{Int64: 9, Valid: true}, &ns); err != nil || !ns.Valid || ns.String != "9" {
+		t.Errorf("To() = %v, %v", ns, err)
+	}
+	if err := safecast.To(sql.NullInt64{}, &ns); err != nil || ns.Valid {
+		t.Errorf("To() = %v, %v", ns, err)
+	}
+	var nt sql.NullTime
+	if err := safecast.To("2024-01-02T03:04:05Z", &nt); err != nil || !nt.Valid || nt.Time.Year() != 2024 {
+		t.Errorf("To() = %v, %v", nt, err)
+	}
+}
+
+func TestNullableDestinationsWithOptions(t *testing.T) {
+	var ni sql.NullInt64
+	if err := safecast.ToWith(3.7, &ni, safecast.WithRoundingMode(safecast.RoundExact)); !errors.Is(err, safecast.ErrFractional) {
+		t.Errorf("ToWith() = %v, %v, want ErrFractional", ni, err)
+	}
+	if err := safecast.ToWith(3.5, &ni, safecast.WithRoundingMode(safecast.RoundHalfEven)); err != nil || !ni.Valid || ni.Int64 != 4 {
+		t.Errorf("ToWith() = %v, %v", ni, err)
+	}
+	if err := safecast.FromWith("2.5", &ni, safecast.WithRoundingMode(safecast.RoundHalfAwayFromZero)); err != nil || !ni.Valid || ni.Int64 != 3 {
+		t.Errorf("FromWith() = %v, %v", ni, err)
+	}
+	c := safecast.NewCaster(safecast.WithRoundingMode(safecast.RoundCeil), safecast.WithTrimSpace())
+	var n8 sql.Null[int8]
+	if err := c.To(" 1.2 ", &n8); err != nil || !n8.Valid || n8.V != 2 {
+		t.Errorf("Caster.To() = %v, %v", n8, err)
+	}
+	var nf sql.NullFloat64
+	if err := c.To(int64(1<<53+1), &nf); err != nil || !nf.Valid {
+		t.Errorf("Caster.To() = %v, %v", nf, err)
+	}
+	if err := safecast.ToWith(int64(1<<53+1), &nf, safecast.WithPrecisionCheck()); !errors.Is(err, safecast.ErrPrecisionLoss) {
+		t.Errorf("ToWith() = %v, %v, want ErrPrecisionLoss", nf, err)
+	}
+
+	// The options also apply to the underlying types of defined types and to json.Number sources.
+	var id UserID
+	if err := safecast.ToWith(2.5, &id, safecast.WithRoundingMode(safecast.RoundHalfEven)); err != nil || id != 2 {
+		t.Errorf("ToWith() = %v, %v", id, err)
+	}
+	if err := safecast.ToWith(json.Number("2.5"), &ni, safecast.WithRoundingMode(safecast.RoundExact)); !errors.Is(err, safecast.ErrFractional) {
+		t.Errorf("ToWith() = %v, %v, want ErrFractional", ni, err)
+	}
+}
+
+func TestScannerDestinations(t *testing.T) {
+	var u upperString
+	if err := safecast.To("abc", &u); err != nil || u.s != "ABC" {
+		t.Errorf("To() = %v, %v", u, err)
+	}
+	if err := safecast.From(sql.NullString{String: "xyz", Valid: true}, &u); err != nil || u.s != "XYZ" {
+		t.Errorf("From() = %v, %v", u, err)
+	}
+	if err := safecast.To(1, &u); !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("To() = %v, want ErrCast", err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+// Level is a defined integer type with a text encoding.
+type Level int
+
+func (l Level) MarshalText() ([]byte, error) {
+	switch l {
+	case 0:
+		return []byte("debug"), nil
+	case 1:
+		return []byte("info"), nil
+	}
+	return nil, fmt.Errorf("unknown level %d", int(l))
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*l = 0
+	case "info":
+		*l = 1
+	default:
+		return fmt.Errorf("unknown level %q", string(text))
+	}
+	return nil
+}
+
+func TestTextUnmarshaler(t *testing.T) {
+	var addr netip.Addr
+	if err := safecast.To("10.0.0.1", &addr); err != nil {
+		t.Fatal(err)
+	}
+	if addr != netip.MustParseAddr("10.0.0.1") {
+		t.Errorf("To() = %v", addr)
+	}
+	if err := safecast.From([]byte("::1"), &addr); err != nil || !addr.IsLoopback() {
+		t.Errorf("From() = %v, %v", addr, err)
+	}
+	if err := safecast.FromString("10.0.0.256", &addr); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("FromString() = %v, want ErrSyntax", err)
+	}
+
+	var l Level
+	if err := safecast.To("INFO", &l); err != nil || l != 1 {
+		t.Errorf("To() = %v, %v", l, err)
+	}
+	if err := safecast.FromBytes([]byte("debug"), &l); err != nil || l != 0 {
+		t.Errorf("FromBytes() = %v, %v", l, err)
+	}
+	// A number is still cast through the underlying kind.
+	if err := safecast.To(1, &l); err != nil || l != 1 {
+		t.Errorf("To() = %v, %v", l, err)
+	}
+	if err := safecast.To("warn", &l); !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("To() = %v, want ErrCast", err)
+	}
+}
+
+func TestTextMarshaler(t *testing.T) {
+	var s string
+	if err := safecast.ToString(netip.MustParseAddr("10.0.0.1"), &s); err != nil || s != "10.0.0.1" {
+		t.Errorf("ToString() = %q, %v", s, err)
+	}
+	if err := safecast.To(net.ParseIP("192.168.0.1"), &s); err != nil || s != "192.168.0.1" {
+		t.Errorf("To() = %q, %v", s, err)
+	}
+	if err := safecast.From(Level(1), &s); err != nil || s != "info" {
+		t.Errorf("From() = %q, %v", s, err)
+	}
+	if err := safecast.To(Level(9), &s); !errors.Is(err, safecast.ErrCast) {
+		t.Errorf("To() = %v, want ErrCast", err)
+	}
+	var b []byte
+	if err := safecast.ToBytes(netip.MustParseAddr("::1"), &b); err != nil || string(b) != "::1" {
+		t.Errorf("ToBytes() = %q, %v", b, err)
+	}
+	if err := safecast.To(Level(0), &b); err != nil || string(b) != "debug" {
+		t.Errorf("To() = %q, %v", b, err)
+	}
+	// A number is still cast through the underlying kind.
+	var i int
+	if err := safecast.To(Level(1), &i); err != nil || i != 1 {
+		t.Errorf("To() = %v, %v", i, err)
+	}
+}
@@ -15,6 +15,7 @@
 package test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -44,14 +45,20 @@ func TestToTime_Extended(t *testing.T) {
 		// []byte format
 		{"[]byte RFC3339", []byte("2022-01-01T15:04:05Z"), false},
 
+		// ISO 8601 formats
+		{"Date only string", "2022-01-01", false},
+		{"ISO 8601 basic format", "20220101T150405Z", false},
+		{"ISO 8601 week date", "2022-W01-6", false},
+		{"ISO 8601 ordinal date", "2022-001", false},
+		{"ISO 8601 comma fraction", "2022-01-01T15:04:05,123+09:00", false},
+
 		// Unsupported string formats - these should error
-		{"Date only string", "2022-01-01", true},
 		{"Time only string", "15:04:05", true},
 		{"Unix timestamp string", "1640995200", true},
+		{"Unix timestamp string with fraction", "1640995200.123", true},
 		{"MM/DD/YYYY", "01/01/2022", true},
 		{"DD/MM/YYYY", "01/01/2022", true},
 		{"YYYY/MM/DD", "2022/01/01", true},
-		{"YYYYMMDD", "20220101", true},
 		{"HHMMSS", "000000", true},
 		{"Kitchen time format", "3:04PM", true},
 		{"RFC822 format", "02 Jan 06 15:04 MST", true},
@@ -59,25 +66,35 @@ func TestToTime_Extended(t *testing.T) {
 		{"ANSIC format", "Mon Jan _2 15:04:05 2006", true},
 		{"Ruby date format", "Mon Jan 02 15:04:05 -0700 2006", true},
 
+		// Numeric strings are parsed as ISO 8601 basic dates, not Unix epoch numbers
+		{"YYYYMMDD", "20220101", false},
+
+		// Unix epoch numbers
+		{"int Unix timestamp", 1640995200, false},
+		{"int8 Unix timestamp", int8(127), false},
+		{"int16 Unix timestamp", int16(32767), false},
+		{"int32 Unix timestamp", int32(1640995200), false},
+		{"int64 Unix timestamp", int64(1640995200), false},
+		{"uint Unix timestamp", uint(1640995200), false},
+		{"uint8 Unix timestamp", uint8(255), false},
+		{"uint16 Unix timestamp", uint16(65535), false},
+		{"uint32 Unix timestamp", uint32(1640995200), false},
+		{"uint64 Unix timestamp", uint64(1640995200), false},
+		{"float32 Unix timestamp", float32(1.6409952e+09), false},
+		{"float64 Unix timestamp", float64(1.640995200123456e+09), false},
+		{"*int to time", func() any { i := 1640995200; return &i }(), false},
+		{"zero Unix timestamp", 0, false},
+		{"negative Unix timestamp", -1, false},
+		{"very large Unix timestamp", int64(253402300799), false},
+		{"max int64 Unix timestamp", int64(9223372036854775807), false},
+		{"max uint64 Unix timestamp", uint64(18446744073709551615), false},
+		{"out of range Unix timestamp string", "1000000000000000000000000000000", true},
+		{"NaN Unix timestamp", math.NaN(), true},
+		{"infinite Unix timestamp", math.Inf(1), true},
+
 		// Unsupported types - these should error
-		{"int Unix timestamp", 1640995200, true},
-		{"int8 Unix timestamp", int8(127), true},
-		{"int16 Unix timestamp", int16(32767), true},
-		{"int32 Unix timestamp", int32(1640995200), true},
-		{"int64 Unix timestamp", int64(1640995200), true},
-		{"uint Unix timestamp", uint(1640995200), true},
-		{"uint8 Unix timestamp", uint8(255), true},
-		{"uint16 Unix timestamp", uint16(65535), true},
-		{"uint32 Unix timestamp", uint32(1640995200), true},
-		{"uint64 Unix timestamp", uint64(1640995200), true},
-		{"float32 Unix timestamp", float32(1.6409952e+09), true},
-		{"float64 Unix timestamp", float64(1.640995200123456e+09), true},
-		{"*int to time", func() any { i := 1640995200; return &i }(), true},
 		{"bool true to time", true, true},
 		{"bool false to time", false, true},
-		{"zero Unix timestamp", 0, true},
-		{"negative Unix timestamp", -1, true},
-		{"very large Unix timestamp", int64(253402300799), true},
 
 		// Invalid string formats
 		{"invalid string", "not a time", true},
@@ -151,8 +151,8 @@ func TestTo_ComprehensiveTypeHandling(t *testing.T) {
 		// *time.Time - comprehensive coverage
 		{"time.Time to *time.Time", time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC), func() *time.Time { var t time.Time; return &t }(), time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC), false},
 		{"string RFC3339 to *time.Time", "2023-05-15T14:30:00Z", func() *time.Time { var t time.Time; return &t }(), time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC), false},
-		{"int unix timestamp to *time.Time", int64(1684158600), func() *time.Time { var t time.Time; return &t }(), time.Unix(1684158600, 0).UTC(), true},                 // int to time not supported
-		{"float64 unix timestamp to *time.Time", float64(1684158600.5), func() *time.Time { var t time.Time; return &t }(), time.Unix(1684158600, 500000000).UTC(), true}, // float to time not supported
+		{"int unix timestamp to *time.Time", int64(1684158600), func() *time.Time { var t time.Time; return &t }(), time.Unix(1684158600, 0).UTC(), false},
+		{"float64 unix timestamp to *time.Time", float64(1684158600.5), func() *time.Time { var t time.Time; return &t }(), time.Unix(1684158600, 500000000).UTC(), false},
 		{"[]byte RFC3339 to *time.Time", []byte("2023-05-15T14:30:00Z"), func() *time.Time { var t time.Time; return &t }(), time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC), false},
 		{"bool true to *time.Time", true, func() *time.Time { var t time.Time; return &t }(), time.Unix(1, 0).UTC(), true},   // bool to time not supported
 		{"bool false to *time.Time", false, func() *time.Time { var t time.Time; return &t }(), time.Unix(0, 0).UTC(), true}, // bool to time not supported
@@ -268,7 +268,7 @@ func TestTo_EdgeCases(t *testing.T) {
 		{"large int64 to *int8", int64(1000), func() *int8 { var i int8; return &i }(), true},
 		{"large uint64 to *uint8", uint64(1000), func() *uint8 { var i uint8; return &i }(), true},
 		{"negative int to *uint", -1, func() *uint { var i uint; return &i }(), true},
-		{"float overflow to *float32", math.MaxFloat64, func() *float32 { var f float32; return &f }(), false}, // doesn't overflow, just inf
+		{"float overflow to *float32", math.MaxFloat64, func() *float32 { var f float32; return &f }(), true}, // overflows float32
 
 		// Edge cases for time parsing
 		{"empty string to *time.Time", "", func() *time.Time { var t time.Time; return &t }(), true},
@@ -0,0 +1,92 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func TestWrap(t *testing.T) {
+	i64 := int64(-1)
+	tests := []struct {
+		from    any
+		to      any
+		want    any
+		wrapped bool
+	}{
+		{128, new(int8), int8(-128), true},
+		{255, new(int8), int8(-1), true},
+		{256, new(int8), int8(0), true},
+		{-129, new(int8), int8(127), true},
+		{127, new(int8), int8(127), false},
+		{-1, new(uint8), uint8(math.MaxUint8), true},
+		{uint16(0x1234), new(uint8), uint8(0x34), true},
+		{int32(-2), new(uint16), uint16(0xfffe), true},
+		{uint32(math.MaxUint32), new(int32), int32(-1), true},
+		{int64(math.MinInt64), new(uint64), uint64(1 << 63), true},
+		{uint64(math.MaxUint64), new(int64), int64(-1), true},
+		{uint64(math.MaxUint64), new(int), int(-1), true},
+		{&i64, new(uint), uint(math.MaxUint), true},
+		{int64(1 << 40), new(uint32), uint32(0), true},
+		{int64(1<<40 + 7), new(int16), int16(7), true},
+		{uint8(200), new(int16), int16(200), false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v=>%T", test.from, test.to), func(t *testing.T) {
+			wrapped, err := safecast.Wrap(test.from, test.to)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if wrapped != test.wrapped {
+				t.Errorf("wrapped = %v, want %v", wrapped, test.wrapped)
+			}
+			if got := reflect.ValueOf(test.to).Elem().Interface(); got != test.want {
+				t.Errorf("Wrap(%v) = %v, want %v", test.from, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWrapDefinedType(t *testing.T) {
+	type Checksum uint8
+	type Offset int64
+	var sum Checksum
+	wrapped, err := safecast.Wrap(Offset(0x1ff), &sum)
+	if err != nil || !wrapped || sum != 0xff {
+		t.Errorf("Wrap() = %v, %v, %v", sum, wrapped, err)
+	}
+}
+
+func TestWrapErrors(t *testing.T) {
+	var i8 int8
+	if _, err := safecast.Wrap(1e10, &i8); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("Wrap(1e10) = %v, want %v", err, safecast.ErrOverflow)
+	}
+	if _, err := safecast.Wrap("abc", &i8); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("Wrap(abc) = %v, want %v", err, safecast.ErrSyntax)
+	}
+	var f32 float32
+	if _, err := safecast.Wrap(1e300, &f32); !errors.Is(err, safecast.ErrOverflow) {
+		t.Errorf("Wrap(1e300) = %v, want %v", err, safecast.ErrOverflow)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2022 The go-safecast Authors All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-safecast/safecast"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+// setDefaultLocation sets the default location, and restores the previous location when the test finishes.
+func setDefaultLocation(t *testing.T, loc *time.Location) {
+	t.Helper()
+	prev := safecast.SetDefaultLocation(loc)
+	t.Cleanup(func() { safecast.SetDefaultLocation(prev) })
+}
+
+// registerZoneAbbreviation registers the time zone abbreviation, and restores the previous registration when the test finishes.
+func registerZoneAbbreviation(t *testing.T, abbr string, loc *time.Location) {
+	t.Helper()
+	prev := safecast.RegisterZoneAbbreviation(abbr, loc)
+	t.Cleanup(func() { safecast.RegisterZoneAbbreviation(abbr, prev) })
+}
+
+func TestToTimeInLocation(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		from any
+		loc  *time.Location
+		want time.Time
+	}{
+		{"2024-01-02 15:04:05", tokyo, time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05", berlin, time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC)},
+		{"2024-07-02T15:04:05", berlin, time.Date(2024, 7, 2, 13, 4, 5, 0, time.UTC)},
+		{"2024-01-02 15:04:05 JST", tokyo, time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)},
+		{"2024-07-02 15:04:05 CEST", berlin, time.Date(2024, 7, 2, 13, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05Z", tokyo, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"2024-01-02 15:04:05 +0100", tokyo, time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC)},
+		{int64(1700000000), tokyo, time.Unix(1700000000, 0)},
+	}
+	for _, tt := range tests {
+		var v time.Time
+		if err := safecast.ToTimeInLocation(tt.from, &v, tt.loc); err != nil {
+			t.Errorf("ToTimeInLocation(%v, %v) = %v", tt.from, tt.loc, err)
+			continue
+		}
+		if !v.Equal(tt.want) {
+			t.Errorf("ToTimeInLocation(%v, %v) = %v, want %v", tt.from, tt.loc, v, tt.want)
+		}
+	}
+
+	var v time.Time
+	if err := safecast.ToTimeInLocation("2024-01-02 15:04:05", &v, tokyo); err != nil || v.Location() != tokyo {
+		t.Errorf("ToTimeInLocation() = %v, %v", v, err)
+	}
+	if err := safecast.ToTimeInLocation("2024-01-02 15:04:05 CET", &v, tokyo); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("ToTimeInLocation() = %v, %v, want ErrSyntax", v, err)
+	}
+}
+
+func TestZoneAbbreviation(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	var v time.Time
+	if err := safecast.ToTime("2024-01-02 15:04:05 JST", &v); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("ToTime() = %v, %v, want ErrSyntax", v, err)
+	}
+	if err := safecast.ToTime("2024-01-02 15:04:05 UTC", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+
+	registerZoneAbbreviation(t, "JST", tokyo)
+
+	if err := safecast.ToTime("2024-01-02 15:04:05 JST", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) || v.Location() != tokyo {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.To("Tue, 02 Jan 2024 15:04:05 JST", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+
+	caster := safecast.NewCaster(safecast.WithZoneAbbreviation("CET", berlin), safecast.WithZoneAbbreviation("CEST", berlin))
+	if err := caster.To("2024-01-02 15:04:05 CET", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC)) {
+		t.Errorf("Caster.To() = %v, %v", v, err)
+	}
+	if err := caster.To("2024-01-02 15:04:05 JST", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("Caster.To() = %v, %v", v, err)
+	}
+	if err := safecast.ToTime("2024-01-02 15:04:05 CET", &v); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("ToTime() = %v, %v, want ErrSyntax", v, err)
+	}
+	if !caster.Equal(time.Date(2024, 7, 2, 13, 4, 5, 0, time.UTC), "2024-07-02 15:04:05 CEST") {
+		t.Errorf("Caster.Equal() = false")
+	}
+
+	// The previously registered location is returned to restore the registration.
+	if prev := safecast.RegisterZoneAbbreviation("JST", berlin); prev != tokyo {
+		t.Errorf("RegisterZoneAbbreviation() = %v, want %v", prev, tokyo)
+	}
+	if prev := safecast.RegisterZoneAbbreviation("JST", tokyo); prev != berlin {
+		t.Errorf("RegisterZoneAbbreviation() = %v, want %v", prev, berlin)
+	}
+	if prev := safecast.RegisterZoneAbbreviation("XYZ", nil); prev != nil {
+		t.Errorf("RegisterZoneAbbreviation() = %v, want nil", prev)
+	}
+
+	// A nil location distrusts the abbreviation registered globally only for the caster.
+	caster = safecast.NewCaster(safecast.WithZoneAbbreviation("JST", nil))
+	if err := caster.To("2024-01-02 15:04:05 JST", &v); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("Caster.To() = %v, %v, want ErrSyntax", v, err)
+	}
+	if err := safecast.ToTime("2024-01-02 15:04:05 JST", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+}
+
+func TestZoneAbbreviationOffset(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	registerZoneAbbreviation(t, "JST", tokyo)
+
+	// The numeric offset must match the offset of the trusted abbreviation at the time.
+	layout := "2006-01-02 15:04:05 -0700 MST"
+	tests := []struct {
+		from    string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2024-01-02 15:04:05 +0900 JST", time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC), false},
+		{"2024-01-02 15:04:05 +0000 JST", time.Time{}, true},
+		{"2024-01-02 15:04:05 +0100 JST", time.Time{}, true},
+		{"2024-01-02 15:04:05 +0100 CET", time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC), false},
+		{"2024-01-02 15:04:05 +0000 XYZ", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		var v time.Time
+		err := safecast.ToTime(tt.from, &v, layout)
+		if tt.wantErr {
+			if !errors.Is(err, safecast.ErrSyntax) {
+				t.Errorf("ToTime(%q) = %v, %v, want ErrSyntax", tt.from, v, err)
+			}
+			continue
+		}
+		if err != nil || !v.Equal(tt.want) {
+			t.Errorf("ToTime(%q) = %v, %v, want %v", tt.from, v, err, tt.want)
+		}
+	}
+
+	// The offset of a location with daylight saving time is checked at the time.
+	caster := safecast.NewCaster(safecast.WithTimeLayouts(layout), safecast.WithZoneAbbreviation("CET", berlin))
+	var v time.Time
+	if err := caster.To("2024-01-02 15:04:05 +0100 CET", &v); err != nil || v.Location() != berlin {
+		t.Errorf("Caster.To() = %v, %v", v, err)
+	}
+	if err := caster.To("2024-07-02 15:04:05 +0100 CET", &v); !errors.Is(err, safecast.ErrSyntax) {
+		t.Errorf("Caster.To() = %v, %v, want ErrSyntax", v, err)
+	}
+}
+
+func TestDefaultLocation(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	setDefaultLocation(t, tokyo)
+
+	var v time.Time
+	if err := safecast.ToTime("2024-01-02 15:04:05", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.ToTime("2024-01-02 15:04:05 JST", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 6, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+	if err := safecast.To(int64(1700000000), &v); err != nil || v.Location() != tokyo {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+	if err := safecast.ToWith("2024-01-02 15:04:05", &v, safecast.WithLocation(berlin)); err != nil || !v.Equal(time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC)) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+
+	// The previous location is returned to restore the setting.
+	if prev := safecast.SetDefaultLocation(nil); prev != tokyo {
+		t.Errorf("SetDefaultLocation() = %v, want %v", prev, tokyo)
+	}
+	if err := safecast.ToTime("2024-01-02 15:04:05", &v); err != nil || !v.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("ToTime() = %v, %v", v, err)
+	}
+}
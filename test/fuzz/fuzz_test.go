@@ -15,6 +15,7 @@
 package test
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -238,3 +239,138 @@ func FuzzFromBool(f *testing.F) {
 		}
 	})
 }
+
+func checkFloatToInteger(t *testing.T, from float64, to float64, err error) {
+	t.Helper()
+	if math.IsNaN(from) {
+		if !errors.Is(err, safecast.ErrNaN) {
+			t.Errorf("%v => %v (%v)", from, to, err)
+		}
+		return
+	}
+	if math.IsInf(from, 0) {
+		if !errors.Is(err, safecast.ErrInfinity) {
+			t.Errorf("%v => %v (%v)", from, to, err)
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+	if to != math.Trunc(from) {
+		t.Errorf("%v => %v", from, to)
+	}
+}
+
+func addSpecialFloats(f *testing.F) {
+	f.Add(math.NaN())
+	f.Add(math.Inf(1))
+	f.Add(math.Inf(-1))
+	f.Add(float64(0))
+	f.Add(float64(-1.5))
+	f.Add(float64(1.5))
+	f.Add(float64(math.MaxFloat64))
+	f.Add(float64(-math.MaxFloat64))
+	f.Add(float64(math.MaxInt64))
+	f.Add(float64(math.MinInt64))
+	f.Add(float64(math.MaxUint64))
+	f.Add(math.Nextafter(float64(math.MaxInt64), 0))
+	f.Add(math.Nextafter(float64(math.MaxUint64), 0))
+}
+
+func FuzzFromFloat64ToInt64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to int64
+		err := safecast.FromFloat64(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzFromFloat64ToUint64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to uint64
+		err := safecast.FromFloat64(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToInt8Float64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to int8
+		err := safecast.ToInt8(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToInt32Float32(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to int32
+		err := safecast.ToInt32(float32(from), &to)
+		checkFloatToInteger(t, float64(float32(from)), float64(to), err)
+	})
+}
+
+func FuzzToInt64Float64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to int64
+		err := safecast.ToInt64(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToIntFloat64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to int
+		err := safecast.ToInt(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToUint16Float64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to uint16
+		err := safecast.ToUint16(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToUint64Float64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to uint64
+		err := safecast.ToUint64(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzToUintFloat64(f *testing.F) {
+	addSpecialFloats(f)
+	f.Fuzz(func(t *testing.T, from float64) {
+		var to uint
+		err := safecast.ToUint(from, &to)
+		checkFloatToInteger(t, from, float64(to), err)
+	})
+}
+
+func FuzzParseISO8601(f *testing.F) {
+	for _, s := range []string{"2024-01-02", "20240102T150405Z", "2024-W12-3", "2024-045", "2024-05", "2024-01-02T15:04:05,123+09:00", "2024-01-02T24:00"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		v, err := safecast.ParseISO8601(s, nil)
+		if err != nil {
+			return
+		}
+		r, err := safecast.ParseISO8601(v.Format("2006-01-02T15:04:05.999999999Z07:00"), nil)
+		if err != nil || !r.Equal(v) {
+			t.Errorf("ParseISO8601(%q) = %v, reparsed %v, %v", s, v, r, err)
+		}
+	})
+}
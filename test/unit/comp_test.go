@@ -148,18 +148,18 @@ func TestCompare(t *testing.T) {
 		if cmp, _ := safecast.Compare([]byte{1, 2, 3}, []byte{1, 2, 3}); cmp != 0 {
 			t.Errorf("Expected 0, got %d", cmp)
 		}
-		if cmp, _ := safecast.Compare([]byte{1, 2, 3}, []byte{1, 2, 4}); cmp <= 0 {
-			t.Errorf("Expected positive, got %d", cmp)
-		}
-		if cmp, _ := safecast.Compare([]byte{1, 2, 4}, []byte{1, 2, 3}); cmp >= 0 {
+		if cmp, _ := safecast.Compare([]byte{1, 2, 3}, []byte{1, 2, 4}); cmp >= 0 {
 			t.Errorf("Expected negative, got %d", cmp)
 		}
-		if cmp, _ := safecast.Compare([]byte{1, 2}, []byte{1, 2, 3}); cmp <= 0 {
-			t.Errorf("Expected positive for longer slice, got %d", cmp)
+		if cmp, _ := safecast.Compare([]byte{1, 2, 4}, []byte{1, 2, 3}); cmp <= 0 {
+			t.Errorf("Expected positive, got %d", cmp)
 		}
-		if cmp, _ := safecast.Compare([]byte{1, 2, 3}, []byte{1, 2}); cmp >= 0 {
+		if cmp, _ := safecast.Compare([]byte{1, 2}, []byte{1, 2, 3}); cmp >= 0 {
 			t.Errorf("Expected negative for shorter slice, got %d", cmp)
 		}
+		if cmp, _ := safecast.Compare([]byte{1, 2, 3}, []byte{1, 2}); cmp <= 0 {
+			t.Errorf("Expected positive for longer slice, got %d", cmp)
+		}
 	})
 }
 